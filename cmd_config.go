@@ -0,0 +1,28 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/yek-j/filemanager/config"
+)
+
+// configCommand: include와 경로 확장이 적용된 최종 설정을 JSON으로 출력한다.
+func configCommand(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("config file required: ./filemanager config <config-file>")
+	}
+
+	cfg, err := config.LoadConfig(args[0])
+	if err != nil {
+		return fmt.Errorf("config load failed: %v", err)
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(data))
+	return nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/runs"
+)
+
+// runsCommand: runs list / runs show <run-id>
+// 마지막 인자로 설정 파일을 주면 그 설정의 runs_path를 사용한다.
+func runsCommand(args []string) error {
+	if len(args) < 1 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	switch args[0] {
+	case "list":
+		baseDir, err := runsDirFromArgs(args[1:])
+		if err != nil {
+			return err
+		}
+		return listRuns(baseDir)
+	case "show":
+		if len(args) < 2 {
+			return fmt.Errorf("run id required: ./filemanager runs show <run-id>")
+		}
+		baseDir, err := runsDirFromArgs(args[2:])
+		if err != nil {
+			return err
+		}
+		return showRun(baseDir, args[1])
+	default:
+		return fmt.Errorf("unknown runs command: %s", args[0])
+	}
+}
+
+func runsDirFromArgs(args []string) (string, error) {
+	if len(args) == 0 {
+		return runsDir(nil), nil
+	}
+
+	cfg, err := config.LoadConfig(args[0])
+	if err != nil {
+		return "", fmt.Errorf("config load failed: %v", err)
+	}
+	return runsDir(cfg), nil
+}
+
+func listRuns(baseDir string) error {
+	summaries, err := runs.List(baseDir)
+	if err != nil {
+		return err
+	}
+
+	if len(summaries) == 0 {
+		fmt.Printf("No runs found in %s\n", baseDir)
+		return nil
+	}
+
+	fmt.Printf("%-40s %-10s %-20s %s\n", "RUN ID", "STATUS", "STARTED", "DURATION")
+	for _, s := range summaries {
+		duration := "-"
+		if !s.FinishedAt.IsZero() {
+			duration = s.FinishedAt.Sub(s.StartedAt).Round(time.Millisecond).String()
+		}
+		fmt.Printf("%-40s %-10s %-20s %s\n", s.ID, s.Status,
+			s.StartedAt.Format("2006-01-02 15:04:05"), duration)
+	}
+	return nil
+}
+
+func showRun(baseDir, id string) error {
+	details, err := runs.Load(baseDir, id)
+	if err != nil {
+		return err
+	}
+
+	s := details.Summary
+	fmt.Printf("Run ID: %s\n", s.ID)
+	if s.Name != "" {
+		fmt.Printf("Workspace: %s\n", s.Name)
+	}
+	fmt.Printf("Status: %s\n", s.Status)
+	if s.Error != "" {
+		fmt.Printf("Error: %s\n", s.Error)
+	}
+	fmt.Printf("Config: %s\n", s.ConfigPath)
+	fmt.Printf("Work path: %s\n", s.WorkPath)
+	fmt.Printf("Started: %s\n", s.StartedAt.Format("2006-01-02 15:04:05"))
+	if !s.FinishedAt.IsZero() {
+		fmt.Printf("Finished: %s\n", s.FinishedAt.Format("2006-01-02 15:04:05"))
+	}
+
+	t := details.Timings
+	fmt.Println("\n--- Timings ---")
+	fmt.Printf("Copy: %s, Process: %s, Total: %s\n", t.Copy, t.Process, t.Total)
+	for _, p := range t.Plugins {
+		fmt.Printf("  %s: %s\n", p.Name, p.Duration)
+	}
+
+	fmt.Println("\n--- Files ---")
+	fmt.Printf("Run directory: %s\n", details.Dir)
+	fmt.Printf("Journal: %s\n", details.JournalPath())
+	for _, logPath := range details.Logs {
+		fmt.Printf("Plugin log: %s\n", logPath)
+	}
+
+	return nil
+}
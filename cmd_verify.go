@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+// verifyCommand: verify <dir|config-file> [manifest-name]
+// 폴더 아래의 모든 매니페스트를 현재 파일과 비교한다. 설정 파일을 주면 work_path를 확인한다.
+func verifyCommand(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("directory or config file required: ./filemanager verify <dir|config-file> [manifest-name]")
+	}
+
+	root := args[0]
+	if info, err := os.Stat(root); err != nil {
+		return err
+	} else if !info.IsDir() {
+		cfg, err := config.LoadConfig(root)
+		if err != nil {
+			return fmt.Errorf("config load failed: %v", err)
+		}
+		root = cfg.WorkPath
+	}
+
+	name := utils.DefaultManifestName
+	if len(args) > 1 {
+		name = args[1]
+	}
+
+	manifests, err := utils.FindManifests(root, name)
+	if err != nil {
+		return err
+	}
+	if len(manifests) == 0 {
+		return fmt.Errorf("no %s found in %s", name, root)
+	}
+
+	failed := 0
+	for _, manifestPath := range manifests {
+		report, err := utils.VerifyManifest(manifestPath)
+		if err != nil {
+			return err
+		}
+		printManifestReport(report)
+		if !report.Clean() {
+			failed++
+		}
+	}
+
+	fmt.Printf("\n%d manifests checked, %d with changes\n", len(manifests), failed)
+	if failed > 0 {
+		return fmt.Errorf("verification failed")
+	}
+	return nil
+}
+
+func printManifestReport(report utils.ManifestReport) {
+	status := "OK"
+	if !report.Clean() {
+		status = "CHANGED"
+	}
+	fmt.Printf("%s: %s (%d ok, %d modified, %d missing, %d new)\n", status, report.ManifestPath,
+		report.OK, len(report.Modified), len(report.Missing), len(report.New))
+
+	dir := filepath.Dir(report.ManifestPath)
+	for _, rel := range report.Modified {
+		fmt.Printf("  MODIFIED: %s\n", filepath.Join(dir, rel))
+	}
+	for _, rel := range report.Missing {
+		fmt.Printf("  MISSING: %s\n", filepath.Join(dir, rel))
+	}
+	for _, rel := range report.New {
+		fmt.Printf("  NEW: %s\n", filepath.Join(dir, rel))
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yek-j/filemanager/utils"
+)
+
+func TestVerifyCommandRelativePath(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "sub"), 0755)
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644)
+	os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("b"), 0644)
+	if _, err := utils.WriteManifest(dir, utils.DefaultManifestName); err != nil {
+		t.Fatalf("WriteManifest failed: %v", err)
+	}
+
+	// 현재 폴더의 매니페스트는 상대 경로(SHA256SUMS)로 찾아진다
+	t.Chdir(dir)
+	if err := verifyCommand([]string{"."}); err != nil {
+		t.Errorf("verify . failed: %v", err)
+	}
+
+	os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("changed"), 0644)
+	if err := verifyCommand([]string{"."}); err == nil {
+		t.Errorf("expected verify . to report the modified file")
+	}
+}
@@ -3,16 +3,23 @@ package config
 import (
 	"encoding/json"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
 // Config 구조체 - JSON 설정과 파일 매핑
 type Config struct {
+	Name          string         `json:"name,omitempty"` // 워크스페이스 이름 (run ID에 사용)
 	SourcePath    string         `json:"source_path"`
 	WorkPath      string         `json:"work_path"`
+	RunsPath      string         `json:"runs_path,omitempty"` // 실행 기록 저장 경로
 	TargetFolders []string       `json:"target_folders"`
 	TargetDepth   int            `json:"file_depth"`
 	Plugin        []PluginConfig `json:"plugin"`
 	SelectiveCopy bool           `json:"selective_copy,omitempty"`
+
+	// LogPath 플러그인 로그 저장 경로 - 실행 시 run 디렉터리로 설정된다
+	LogPath string `json:"-"`
 }
 
 // PluginConfig 구조체 - Plugin Json 설정
@@ -21,13 +28,20 @@ type PluginConfig struct {
 	Config json.RawMessage `json:"config"`
 }
 
-// LoadConfig JSON 파일에서 설정을 읽어 온다.
+// LoadConfig 설정 파일(JSON, YAML, TOML)에서 설정을 읽어 온다.
+// 형식은 확장자로 결정하고 include된 파일을 합친 뒤
+// 경로의 ${ENV_VAR}와 ~를 확장한다.
 // configPath: 설정 파일 경로
 // return: Config 구조체 포인터와 에러
 func LoadConfig(configPath string) (*Config, error) {
-	// 파일 읽기
-	configData, err := os.ReadFile(configPath)
+	// 파일 읽기 + include 병합
+	raw, err := loadRawConfig(configPath, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
 
+	// 형식과 관계 없이 JSON으로 변환해서 Config에 매핑
+	configData, err := json.Marshal(raw)
 	if err != nil {
 		return nil, err
 	}
@@ -39,5 +53,80 @@ func LoadConfig(configPath string) (*Config, error) {
 		return nil, err
 	}
 
+	// target_folders가 없으면 플러그인 target_folders의 합집합을 사용
+	if len(config.TargetFolders) == 0 {
+		config.TargetFolders = config.PluginTargetFolders()
+	}
+
+	config.SourcePath = ExpandPath(config.SourcePath)
+	config.WorkPath = ExpandPath(config.WorkPath)
+	config.RunsPath = ExpandPath(config.RunsPath)
+
 	return &config, nil
 }
+
+// ExpandPath 경로의 ${ENV_VAR}(또는 $ENV_VAR)와 맨 앞의 ~를 확장한다.
+func ExpandPath(path string) string {
+	if path == "" {
+		return path
+	}
+
+	path = os.ExpandEnv(path)
+
+	if path == "~" || strings.HasPrefix(path, "~/") || strings.HasPrefix(path, `~\`) {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[1:])
+		}
+	}
+
+	return path
+}
+
+// PluginTargetFolders 모든 플러그인 target_folders의 합집합을 등장 순서대로 반환한다.
+func (c *Config) PluginTargetFolders() []string {
+	var folders []string
+	seen := make(map[string]bool)
+
+	for _, plugin := range c.Plugin {
+		for _, folder := range plugin.TargetFolders() {
+			if !seen[folder] {
+				seen[folder] = true
+				folders = append(folders, folder)
+			}
+		}
+	}
+
+	return folders
+}
+
+// TargetFolders 플러그인 설정에서 target_folders만 읽는다.
+func (p *PluginConfig) TargetFolders() []string {
+	var scope struct {
+		TargetFolders []string `json:"target_folders"`
+	}
+
+	if len(p.Config) > 0 {
+		json.Unmarshal(p.Config, &scope) // 형식이 다르면 플러그인에서 에러 처리
+	}
+
+	return scope.TargetFolders
+}
+
+// HasPlugin name 플러그인이 설정되어 있는지 확인한다.
+func (c *Config) HasPlugin(name string) bool {
+	for _, plugin := range c.Plugin {
+		if plugin.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLogPath 플러그인 로그를 저장할 경로를 반환한다.
+// LogPath가 없으면 work_path에 저장한다.
+func (c *Config) GetLogPath() string {
+	if c.LogPath != "" {
+		return c.LogPath
+	}
+	return c.WorkPath
+}
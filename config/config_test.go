@@ -1,6 +1,9 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -22,3 +25,118 @@ func TestLoadConfig(t *testing.T) {
         t.Errorf("Expected TargetDepth 3, got %d", config.TargetDepth)
     }
 }
+
+func TestLoadConfigYAMLWithInclude(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("FM_TEST_ROOT", "/data")
+
+	shared := `
+plugin:
+  - name: underscore_number
+    config:
+      allowed_extensions: [pdf]
+      target_folders: [paper]
+`
+	mainConfig := `
+# 공용 플러그인 설정 불러오기
+include: shared.yaml
+source_path: ${FM_TEST_ROOT}/root
+work_path: ~/work
+target_folders: [paper]
+file_depth: 2
+plugin:
+  - name: file_relocator
+    config:
+      target_folders: [paper]
+`
+	os.WriteFile(filepath.Join(dir, "shared.yaml"), []byte(shared), 0644)
+	os.WriteFile(filepath.Join(dir, "main.yaml"), []byte(mainConfig), 0644)
+
+	config, err := LoadConfig(filepath.Join(dir, "main.yaml"))
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	if config.SourcePath != "/data/root" {
+		t.Errorf("Expected SourcePath '/data/root', got '%s'", config.SourcePath)
+	}
+
+	home, _ := os.UserHomeDir()
+	if config.WorkPath != filepath.Join(home, "work") {
+		t.Errorf("Expected WorkPath under home, got '%s'", config.WorkPath)
+	}
+
+	// include된 플러그인이 먼저 실행된다
+	if len(config.Plugin) != 2 || config.Plugin[0].Name != "underscore_number" {
+		t.Fatalf("Expected included plugin first, got %+v", config.Plugin)
+	}
+}
+
+func TestLoadConfigTOML(t *testing.T) {
+	dir := t.TempDir()
+	data := `
+source_path = "/path/to/root"
+work_path = "/path/to/work"
+target_folders = ["paper"]
+file_depth = 3
+
+[[plugin]]
+name = "underscore_number"
+
+[plugin.config]
+allowed_extensions = ["pdf"]
+target_folders = ["paper"]
+`
+	configPath := filepath.Join(dir, "config.toml")
+	os.WriteFile(configPath, []byte(data), 0644)
+
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	if config.TargetDepth != 3 {
+		t.Errorf("Expected TargetDepth 3, got %d", config.TargetDepth)
+	}
+	if len(config.Plugin) != 1 || !strings.Contains(string(config.Plugin[0].Config), `"pdf"`) {
+		t.Errorf("Unexpected plugin config: %+v", config.Plugin)
+	}
+}
+
+func TestLoadConfigCircularInclude(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("include: b.yaml\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "b.yaml"), []byte("include: a.yaml\n"), 0644)
+
+	if _, err := LoadConfig(filepath.Join(dir, "a.yaml")); err == nil {
+		t.Fatal("Expected circular include error")
+	}
+}
+
+func TestLoadConfigDerivesTargetFolders(t *testing.T) {
+	dir := t.TempDir()
+	data := `
+source_path: /path/to/root
+work_path: /path/to/work
+file_depth: 3
+plugin:
+  - name: underscore_number
+    config:
+      target_folders: [paper, homework]
+  - name: file_relocator
+    config:
+      target_folders: [music, paper]
+`
+	configPath := filepath.Join(dir, "config.yaml")
+	os.WriteFile(configPath, []byte(data), 0644)
+
+	config, err := LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+
+	expected := []string{"paper", "homework", "music"}
+	if strings.Join(config.TargetFolders, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected TargetFolders %v, got %v", expected, config.TargetFolders)
+	}
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// includeKey 다른 설정 파일을 불러오는 키 (문자열 또는 문자열 목록)
+const includeKey = "include"
+
+// pluginKey include 시 덮어쓰지 않고 이어 붙이는 키
+const pluginKey = "plugin"
+
+// loadRawConfig: 설정 파일을 확장자에 맞게 읽고 include를 병합한 map을 반환한다.
+// visited: include 순환 참조 확인용
+func loadRawConfig(configPath string, visited map[string]bool) (map[string]any, error) {
+	absPath, err := filepath.Abs(configPath)
+	if err != nil {
+		return nil, err
+	}
+	if visited[absPath] {
+		return nil, fmt.Errorf("circular include: %s", configPath)
+	}
+	visited[absPath] = true
+	defer delete(visited, absPath)
+
+	configData, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := decodeConfig(configData, filepath.Ext(configPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", configPath, err)
+	}
+
+	includes, err := includePaths(raw[includeKey])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", configPath, err)
+	}
+	delete(raw, includeKey)
+
+	// include된 설정을 먼저 합치고 현재 파일 값으로 덮어쓴다
+	merged := map[string]any{}
+	for _, include := range includes {
+		include = ExpandPath(include)
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(configPath), include)
+		}
+
+		included, err := loadRawConfig(include, visited)
+		if err != nil {
+			return nil, err
+		}
+		mergeConfig(merged, included)
+	}
+	mergeConfig(merged, raw)
+
+	return merged, nil
+}
+
+// decodeConfig: 확장자별로 설정 파일을 map으로 읽는다.
+func decodeConfig(data []byte, ext string) (map[string]any, error) {
+	raw := map[string]any{}
+
+	switch strings.ToLower(ext) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	case ".toml":
+		if err := toml.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	default:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber() // 숫자 값을 그대로 유지
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, err
+		}
+	}
+
+	if raw == nil {
+		raw = map[string]any{} // 빈 YAML 파일
+	}
+	return raw, nil
+}
+
+func includePaths(value any) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []any:
+		paths := make([]string, 0, len(v))
+		for _, item := range v {
+			path, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("include must be a list of paths")
+			}
+			paths = append(paths, path)
+		}
+		return paths, nil
+	default:
+		return nil, fmt.Errorf("include must be a path or a list of paths")
+	}
+}
+
+// mergeConfig: src를 dst에 합친다.
+// map은 재귀적으로 합치고 plugin 목록은 이어 붙이며 나머지는 덮어쓴다.
+func mergeConfig(dst, src map[string]any) {
+	for key, value := range src {
+		switch v := value.(type) {
+		case map[string]any:
+			if existing, ok := dst[key].(map[string]any); ok {
+				mergeConfig(existing, v)
+				continue
+			}
+		case []any:
+			if existing, ok := dst[key].([]any); ok && key == pluginKey {
+				dst[key] = append(existing, v...)
+				continue
+			}
+		case []map[string]any: // TOML 배열 테이블
+			items := make([]any, len(v))
+			for i, item := range v {
+				items[i] = item
+			}
+			if existing, ok := dst[key].([]any); ok && key == pluginKey {
+				dst[key] = append(existing, items...)
+			} else {
+				dst[key] = items
+			}
+			continue
+		}
+		dst[key] = value
+	}
+}
@@ -8,18 +8,46 @@ import (
 
 	"github.com/yek-j/filemanager/config"
 	"github.com/yek-j/filemanager/plugins"
+	"github.com/yek-j/filemanager/runs"
 	"github.com/yek-j/filemanager/utils"
 )
 
 func main() {
 	if len(os.Args) < 2 {
-		fmt.Println("Usage: ./filemanager <config-file>")
-		fmt.Println("Example: ./filemanager my-config.json")
+		printUsage()
 		os.Exit(1)
 	}
 
-	configPath := os.Args[1]
+	switch os.Args[1] {
+	case "config":
+		if err := configCommand(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+	case "runs":
+		if err := runsCommand(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+	case "verify":
+		if err := verifyCommand(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		processCommand(os.Args[1])
+	}
+}
 
+func printUsage() {
+	fmt.Println("Usage: ./filemanager <config-file>")
+	fmt.Println("       ./filemanager config <config-file>")
+	fmt.Println("       ./filemanager runs list [config-file]")
+	fmt.Println("       ./filemanager runs show <run-id> [config-file]")
+	fmt.Println("       ./filemanager verify <dir|config-file> [manifest-name]")
+	fmt.Println("Example: ./filemanager my-config.json")
+	fmt.Println("Config files can be JSON, YAML (.yaml, .yml) or TOML (.toml)")
+}
+
+// processCommand: 설정 파일로 작업을 실행하고 run 디렉터리에 기록을 남긴다.
+func processCommand(configPath string) {
 	cfg, err := config.LoadConfig(configPath)
 	if err != nil {
 		log.Fatal("Config load failed: ", err)
@@ -28,64 +56,117 @@ func main() {
 	fmt.Printf("Work path: %s\n", cfg.WorkPath)
 	fmt.Println("✅ Config loaded successfully")
 
+	// run 디렉터리 생성
+	run, err := runs.Start(runsDir(cfg), cfg.Name, configPath, cfg.WorkPath)
+	if err != nil {
+		log.Fatal("Run start failed: ", err)
+	}
+	fmt.Printf("Run ID: %s\n", run.Summary.ID)
+
+	// 플러그인 로그는 run 디렉터리에 저장
+	cfg.LogPath = run.LogsDir()
+	if err := run.SaveConfig(cfg); err != nil {
+		fmt.Printf("Warning: Failed to save config to run directory: %v\n", err)
+	}
+
+	status, err := process(cfg, run)
+	if finishErr := run.Finish(status, err); finishErr != nil {
+		fmt.Printf("Warning: Failed to save run history: %v\n", finishErr)
+	}
+	fmt.Printf("📝 Run history saved: %s\n", run.Dir)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// process: ScanFiles -> CopyRootDir -> 플러그인 순서로 작업하고 run 상태를 반환한다.
+func process(cfg *config.Config, run *runs.Run) (string, error) {
 	// ScanFiles
 	fmt.Println("\n--- ScanFiles ---")
 	scanReport, err := utils.ScanFiles(cfg)
 	if err != nil {
-		log.Fatal("scanFiles failed: ", err)
+		return runs.StatusFailed, fmt.Errorf("scanFiles failed: %v", err)
 	}
+	if err := run.SaveScanReport(scanReport); err != nil {
+		fmt.Printf("Warning: Failed to save scan report: %v\n", err)
+	}
+	run.Logf("scan completed: %d files, ready to process: %v", scanReport.TotalFiles, scanReport.ReadyToProcess)
 
 	// 결과 출력
 	fmt.Printf("Root exists: %v\n", scanReport.RootExists)
 	fmt.Printf("Ready to process: %v\n", scanReport.ReadyToProcess)
 	fmt.Printf("Total files: %d\n", scanReport.TotalFiles)
+	if cfg.HasPlugin("dedupe") {
+		fmt.Printf("Estimated duplicate bytes: %d\n", scanReport.DuplicateBytes)
+	}
+	for _, warning := range scanReport.Warnings {
+		fmt.Printf("⚠️ Warning: %s\n", warning)
+		run.Logf("warning: %s", warning)
+	}
 
 	// copyRootDir
 	fmt.Println("\n--- copyRootDir ---")
-	if scanReport.ReadyToProcess {
-		workStartTime := time.Now()
-		fmt.Printf("Starting file processing at %s\n", workStartTime.Format("15:04:05"))
+	if !scanReport.ReadyToProcess {
+		fmt.Println("CHECK: System not ready for processing")
+		return runs.StatusNotReady, nil
+	}
 
-		copyStartTime := time.Now()
-		err = utils.CopyRootDir(cfg)
+	workStartTime := time.Now()
+	fmt.Printf("Starting file processing at %s\n", workStartTime.Format("15:04:05"))
 
+	copyStartTime := time.Now()
+	err = utils.CopyRootDir(cfg)
+
+	if err != nil {
+		return runs.StatusFailed, fmt.Errorf("CopyRootDir failed: %v", err)
+	}
+	fmt.Println("✅ Copy completed successfully")
+
+	copyDuration := time.Since(copyStartTime)
+	fmt.Printf("✅ Backup completed in %v\n", copyDuration)
+	run.Timings.Copy = copyDuration.String()
+	run.Logf("copy completed in %v", copyDuration)
+
+	// 플러그인 실행 - 순서대로
+	processStartTime := time.Now()
+	for _, pluginCfg := range cfg.Plugin {
+		pluginStartTime := time.Now()
+		plugin, err := plugins.GetPlugin(&pluginCfg)
 		if err != nil {
-			log.Fatal("CopyRootDir failed: ", err)
+			return runs.StatusFailed, fmt.Errorf("Plugin not found: %v", err)
 		}
-		fmt.Println("✅ Copy completed successfully")
-
-		copyDuration := time.Since(copyStartTime)
-		fmt.Printf("✅ Backup completed in %v\n", copyDuration)
-
-		// 플러그인 실행 - 순서대로
-		processStartTime := time.Now()
-		for _, pluginCfg := range cfg.Plugin {
-			pluginStartTime := time.Now()
-			plugin, err := plugins.GetPlugin(&pluginCfg)
-			if err != nil {
-				log.Fatal("Plugin not found: ", err)
-			}
-
-			fmt.Printf("Plugin: %s\n", plugin.GetName())
-			err = plugin.Process(cfg)
-
-			if err != nil {
-				log.Fatal("Plugin process failed: ", err)
-			}
-
-			fmt.Printf("⭕ %s Plugin processing completed\n", pluginCfg.Name)
-			pluginDuration := time.Since(pluginStartTime)
-			fmt.Printf("%s Plugin work time: %v\n", pluginCfg.Name, pluginDuration)
+
+		fmt.Printf("Plugin: %s\n", plugin.GetName())
+		run.Logf("plugin %s started", pluginCfg.Name)
+		err = plugin.Process(cfg)
+
+		if err != nil {
+			return runs.StatusFailed, fmt.Errorf("Plugin process failed: %v", err)
 		}
 
-		fmt.Println("✅ Plugin processing completed")
-		processDuration := time.Since(processStartTime)
-		fmt.Printf("✅ File processing completed in %v\n", processDuration)
+		fmt.Printf("⭕ %s Plugin processing completed\n", pluginCfg.Name)
+		pluginDuration := time.Since(pluginStartTime)
+		fmt.Printf("%s Plugin work time: %v\n", pluginCfg.Name, pluginDuration)
+		run.AddPluginTiming(pluginCfg.Name, pluginDuration)
+	}
 
-		// 전체 작업 시간
-		totalWorkTime := time.Since(workStartTime)
-		fmt.Printf("Total work time: %v (Copy: %v, Process: %v)\n", totalWorkTime, copyDuration, processDuration)
-	} else {
-		fmt.Println("CHECK: System not ready for processing")
+	fmt.Println("✅ Plugin processing completed")
+	processDuration := time.Since(processStartTime)
+	fmt.Printf("✅ File processing completed in %v\n", processDuration)
+	run.Timings.Process = processDuration.String()
+
+	// 전체 작업 시간
+	totalWorkTime := time.Since(workStartTime)
+	fmt.Printf("Total work time: %v (Copy: %v, Process: %v)\n", totalWorkTime, copyDuration, processDuration)
+
+	return runs.StatusSuccess, nil
+}
+
+// runsDir: 설정의 runs_path, 없으면 기본 경로
+func runsDir(cfg *config.Config) string {
+	if cfg != nil && cfg.RunsPath != "" {
+		return cfg.RunsPath
 	}
+	return runs.DefaultDir()
 }
@@ -0,0 +1,314 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Archive struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 압축 파일을 만드는 단위
+const (
+	ArchivePerDirectory    = "directory"     // depth 폴더마다 하나 (기본값)
+	ArchivePerTargetFolder = "target_folder" // 타겟 폴더마다 하나
+	ArchiveSingle          = "single"        // 전체를 하나로 (work_path에 생성)
+)
+
+const defaultArchiveNameTemplate = "{folder}_{date}"
+
+// Archive 플러그인의 설정값 구조체
+type ArchiveConfig struct {
+	SearchSubdirs bool `json:"search_subdirs"` // 하위 폴더까지 검색 여부
+
+	Format          string `json:"format,omitempty"`        // zip, tar.gz
+	Scope           string `json:"scope,omitempty"`         // directory, target_folder, single
+	NameTemplate    string `json:"name_template,omitempty"` // {folder}, {date}, {time} (확장자 제외)
+	RemoveOriginals bool   `json:"remove_originals"`        // 압축 내용 확인 후 원본 삭제
+
+	// 압축할 파일 선택 조건
+	FileFilter
+
+	// 압축할 타겟 폴더와 깊이
+	TargetScope
+}
+
+type ArchiveLog struct {
+	Archives     []ArchiveFileLog
+	RemovedFiles int
+	Format       string
+	TotalFiles   int
+}
+
+type ArchiveFileLog struct {
+	Path    string
+	Entries []string
+}
+
+// archiveGroup: 압축 파일 하나에 들어갈 파일 목록
+type archiveGroup struct {
+	baseDir string   // 압축 파일을 만들 폴더 - 압축 파일 안의 경로 기준
+	dirs    []string // 파일을 모을 폴더
+}
+
+func (a *Archive) Process(cfg *config.Config) error {
+	totalProcessed := 0
+
+	// 설정 구조체
+	var pluginConfig ArchiveConfig
+
+	// Config 파싱
+	if a.pluginCfg != nil && len(a.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(a.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	switch pluginConfig.Format {
+	case "":
+		pluginConfig.Format = utils.ArchiveZip
+	case utils.ArchiveZip, utils.ArchiveTarGz:
+	default:
+		return fmt.Errorf("unknown archive format: %s", pluginConfig.Format)
+	}
+
+	if pluginConfig.NameTemplate == "" {
+		pluginConfig.NameTemplate = defaultArchiveNameTemplate
+	}
+	if strings.ContainsAny(pluginConfig.NameTemplate, `/\`) {
+		return fmt.Errorf("name_template must not contain path separators: %s", pluginConfig.NameTemplate)
+	}
+
+	now := time.Now()
+	filter, err := pluginConfig.FileFilter.compile(now)
+	if err != nil {
+		return err
+	}
+
+	groups, err := archiveGroups(cfg, pluginConfig)
+	if err != nil {
+		return err
+	}
+
+	log := &ArchiveLog{Format: pluginConfig.Format}
+	for _, group := range groups {
+		count, err := archiveGroupFiles(group, pluginConfig, filter, now, log)
+		totalProcessed += count
+		if err != nil {
+			return err
+		}
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("archive_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeArchiveLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// archiveGroups: scope에 따라 압축 파일 단위로 작업 경로를 묶는다.
+func archiveGroups(cfg *config.Config, pluginConfig ArchiveConfig) ([]archiveGroup, error) {
+	switch pluginConfig.Scope {
+	case "", ArchivePerDirectory:
+		workDirs, err := pluginConfig.workDirs(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		groups := make([]archiveGroup, 0, len(workDirs))
+		for _, dir := range workDirs {
+			groups = append(groups, archiveGroup{baseDir: dir, dirs: []string{dir}})
+		}
+		return groups, nil
+	case ArchivePerTargetFolder:
+		basePaths, err := pluginConfig.targetFolderPaths(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		groups := make([]archiveGroup, 0, len(basePaths))
+		for _, basePath := range basePaths {
+			dirs, err := pluginConfig.dirsIn(basePath, cfg)
+			if err != nil {
+				return nil, err
+			}
+			groups = append(groups, archiveGroup{baseDir: basePath, dirs: dirs})
+		}
+		return groups, nil
+	case ArchiveSingle:
+		workDirs, err := pluginConfig.workDirs(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return []archiveGroup{{baseDir: cfg.WorkPath, dirs: workDirs}}, nil
+	default:
+		return nil, fmt.Errorf("unknown archive scope: %s", pluginConfig.Scope)
+	}
+}
+
+// archiveGroupFiles: group의 대상 파일을 모아 baseDir에 압축 파일 하나를 만든다.
+func archiveGroupFiles(group archiveGroup, pluginConfig ArchiveConfig, filter *fileMatcher, now time.Time, log *ArchiveLog) (int, error) {
+	var entries []utils.ArchiveEntry
+	seen := make(map[string]bool)
+	outputPattern := archiveOutputPattern(pluginConfig)
+
+	addFile := func(path string, d fs.DirEntry) error {
+		if seen[path] || !filter.match(path, d) {
+			return nil
+		}
+		// 이전 실행에서 만든 압축 파일은 다시 압축하지 않음 (remove_originals로 삭제되는 것도 막음)
+		if isArchiveOutput(d.Name(), outputPattern) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(group.baseDir, path)
+		if err != nil {
+			return err
+		}
+		seen[path] = true
+		entries = append(entries, utils.ArchiveEntry{Path: path, Name: filepath.ToSlash(rel)})
+		return nil
+	}
+
+	for _, dir := range group.dirs {
+		if pluginConfig.SearchSubdirs {
+			err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+				return addFile(path, d)
+			})
+			if err != nil {
+				return 0, err
+			}
+		} else {
+			dirEntries, err := os.ReadDir(dir)
+			if err != nil {
+				return 0, err
+			}
+			for _, entry := range dirEntries {
+				if entry.IsDir() {
+					continue
+				}
+				if err := addFile(filepath.Join(dir, entry.Name()), entry); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	archivePath := archiveFilePath(group.baseDir, pluginConfig, now)
+	if err := utils.WriteArchive(archivePath, pluginConfig.Format, entries); err != nil {
+		return 0, fmt.Errorf("failed to create archive %s: %v", archivePath, err)
+	}
+
+	fileLog := ArchiveFileLog{Path: archivePath}
+	for _, entry := range entries {
+		fileLog.Entries = append(fileLog.Entries, entry.Name)
+	}
+	log.Archives = append(log.Archives, fileLog)
+
+	if pluginConfig.RemoveOriginals {
+		// 압축 파일을 다시 읽어 모든 파일의 내용이 같을 때만 원본 삭제
+		if err := utils.VerifyArchive(archivePath, pluginConfig.Format, entries); err != nil {
+			return len(entries), fmt.Errorf("archive verification failed for %s: %v", archivePath, err)
+		}
+		for _, entry := range entries {
+			if err := os.Remove(entry.Path); err != nil {
+				return len(entries), err
+			}
+			log.RemovedFiles++
+		}
+	}
+
+	return len(entries), nil
+}
+
+// archiveFilePath: name_template으로 압축 파일 경로를 만든다. 같은 이름이 있으면 _1, _2...를 붙인다.
+func archiveFilePath(baseDir string, pluginConfig ArchiveConfig, now time.Time) string {
+	name := strings.NewReplacer(
+		"{folder}", filepath.Base(baseDir),
+		"{date}", now.Format("20060102"),
+		"{time}", now.Format("150405"),
+	).Replace(pluginConfig.NameTemplate)
+
+	ext := "." + pluginConfig.Format
+	archivePath := filepath.Join(baseDir, name+ext)
+	for i := 1; ; i++ {
+		if _, err := os.Stat(archivePath); os.IsNotExist(err) {
+			return archivePath
+		}
+		archivePath = filepath.Join(baseDir, fmt.Sprintf("%s_%d%s", name, i, ext))
+	}
+}
+
+// archiveOutputPattern: name_template과 format으로 이 플러그인이 만드는 압축 파일 이름의 정규식을 만든다.
+func archiveOutputPattern(pluginConfig ArchiveConfig) *regexp.Regexp {
+	pattern := strings.NewReplacer(
+		`\{folder\}`, `.+`,
+		`\{date\}`, `\d{8}`,
+		`\{time\}`, `\d{6}`,
+	).Replace(regexp.QuoteMeta(pluginConfig.NameTemplate))
+
+	return regexp.MustCompile(`(?i)^` + pattern + `(_\d+)?` + regexp.QuoteMeta("."+pluginConfig.Format) + `$`)
+}
+
+// isArchiveOutput: name_template으로 만든 이름인지 확인한다. 사용자가 넣어 둔 다른 압축 파일은 그대로 압축한다.
+func isArchiveOutput(name string, outputPattern *regexp.Regexp) bool {
+	return outputPattern.MatchString(utils.NormalizeName(name))
+}
+
+func writeArchiveLogFile(log *ArchiveLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Archive Processing Log\n")
+	fmt.Fprintf(file, "Format: %s\n", log.Format)
+	fmt.Fprintf(file, "Total files processed: %d\n", log.TotalFiles)
+	fmt.Fprintf(file, "Originals removed: %d\n\n", log.RemovedFiles)
+
+	fmt.Fprintf(file, "=== ARCHIVES ===\n")
+	for _, archive := range log.Archives {
+		fmt.Fprintf(file, "ARCHIVE: %s (%d files)\n", archive.Path, len(archive.Entries))
+		for _, entry := range archive.Entries {
+			fmt.Fprintf(file, "  %s\n", entry)
+		}
+	}
+
+	return nil
+}
+
+func (a *Archive) GetName() string {
+	return "ARCHIVE"
+}
+
+func (a *Archive) GetDescription() string {
+	return "조건에 맞는 파일을 zip 또는 tar.gz로 압축합니다. " +
+		"폴더별, 타겟 폴더별 또는 하나의 파일로 묶고, 내용을 확인한 뒤 원본을 삭제할 수 있습니다."
+}
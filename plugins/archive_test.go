@@ -0,0 +1,130 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yek-j/filemanager/utils"
+)
+
+func TestArchiveGroupFiles(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "sub"), 0755)
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644)
+	os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("b"), 0644)
+	os.WriteFile(filepath.Join(dir, "c.pdf"), []byte("c"), 0644)
+
+	pluginConfig := ArchiveConfig{
+		FileFilter:      FileFilter{FileExtensions: []string{"txt"}},
+		SearchSubdirs:   true,
+		Format:          utils.ArchiveTarGz,
+		NameTemplate:    "{folder}_{date}",
+		RemoveOriginals: true,
+	}
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	log := &ArchiveLog{}
+	filter, err := pluginConfig.FileFilter.compile(now)
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+
+	count, err := archiveGroupFiles(archiveGroup{baseDir: dir, dirs: []string{dir}}, pluginConfig, filter, now, log)
+	if err != nil {
+		t.Fatalf("archiveGroupFiles failed: %v", err)
+	}
+	if count != 2 || log.RemovedFiles != 2 {
+		t.Errorf("expected 2 archived and removed, got %d, %d", count, log.RemovedFiles)
+	}
+
+	archivePath := filepath.Join(dir, filepath.Base(dir)+"_20240501.tar.gz")
+	hashes, err := utils.HashArchive(archivePath, utils.ArchiveTarGz)
+	if err != nil {
+		t.Fatalf("HashArchive failed: %v", err)
+	}
+	if _, ok := hashes["sub/b.txt"]; !ok || len(hashes) != 2 {
+		t.Errorf("unexpected archive contents: %v", hashes)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "a.txt")); !os.IsNotExist(err) {
+		t.Errorf("a.txt should be removed")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "c.pdf")); err != nil {
+		t.Errorf("c.pdf should remain: %v", err)
+	}
+
+	// 같은 이름이 있으면 번호를 붙임
+	if got := archiveFilePath(dir, pluginConfig, now); filepath.Base(got) != filepath.Base(dir)+"_20240501_1.tar.gz" {
+		t.Errorf("unexpected archive path: %s", got)
+	}
+}
+
+func TestArchiveGroupFilesSkipsPreviousArchives(t *testing.T) {
+	now := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		extensions []string
+		expected   int
+	}{
+		{"no extension filter", nil, 2},                 // a.txt, photos.zip
+		{"zip in extension filter", []string{"zip"}, 1}, // photos.zip
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			previous := filepath.Join(dir, filepath.Base(dir)+"_20240501.zip")
+			os.WriteFile(previous, []byte("old"), 0644)
+			os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644)
+			os.WriteFile(filepath.Join(dir, "photos.zip"), []byte("p"), 0644)
+
+			pluginConfig := ArchiveConfig{
+				FileFilter:      FileFilter{FileExtensions: tc.extensions},
+				Format:          utils.ArchiveZip,
+				NameTemplate:    "{folder}_{date}",
+				RemoveOriginals: true,
+			}
+			log := &ArchiveLog{}
+			filter, _ := pluginConfig.FileFilter.compile(now)
+
+			count, err := archiveGroupFiles(archiveGroup{baseDir: dir, dirs: []string{dir}}, pluginConfig, filter, now, log)
+			if err != nil {
+				t.Fatalf("archiveGroupFiles failed: %v", err)
+			}
+			if count != tc.expected {
+				t.Errorf("expected %d archived files, got %d (%v)", tc.expected, count, log.Archives)
+			}
+			if _, err := os.Stat(previous); err != nil {
+				t.Errorf("previous archive should remain: %v", err)
+			}
+			// 사용자가 넣어 둔 압축 파일은 다른 파일처럼 압축 후 삭제
+			if _, err := os.Stat(filepath.Join(dir, "photos.zip")); !os.IsNotExist(err) {
+				t.Errorf("photos.zip should be archived and removed")
+			}
+		})
+	}
+}
+
+func TestIsArchiveOutput(t *testing.T) {
+	pluginConfig := ArchiveConfig{
+		Format:       utils.ArchiveTarGz,
+		NameTemplate: "backup_{date}_{time}",
+	}
+	pattern := archiveOutputPattern(pluginConfig)
+
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{"backup_20240501_120000.tar.gz", true},
+		{"backup_20240501_120000_2.TAR.GZ", true},
+		{"backup_latest.tar.gz", false},
+		{"backup_20240501_120000.zip", false}, // 다른 형식
+	}
+	for _, tc := range tests {
+		if got := isArchiveOutput(tc.name, pattern); got != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, got)
+		}
+	}
+}
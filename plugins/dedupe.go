@@ -0,0 +1,281 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Dedupe struct {
+	pluginCfg *config.PluginConfig
+}
+
+// keep 정책
+const (
+	KeepOldest          = "oldest"           // 수정 시간이 가장 오래된 파일 (기본값)
+	KeepNewest          = "newest"           // 수정 시간이 가장 최근인 파일
+	KeepShortestPath    = "shortest_path"    // 경로가 가장 짧은 파일
+	KeepPreferredFolder = "preferred_folder" // preferred_folders 순서대로 우선
+)
+
+// 중복 파일 처리 방식
+const (
+	DedupeDelete   = "delete"   // 삭제
+	DedupeHardlink = "hardlink" // 남긴 파일의 하드링크로 교체
+	DedupeReport   = "report"   // 로그에만 기록 (기본값)
+)
+
+// Dedupe 플러그인의 설정값 구조체
+type DedupeConfig struct {
+	Keep             string   `json:"keep,omitempty"`              // oldest, newest, shortest_path, preferred_folder
+	PreferredFolders []string `json:"preferred_folders,omitempty"` // work_path 기준 상대 경로, 앞에 있을수록 우선
+	Action           string   `json:"action,omitempty"`            // delete, hardlink, report
+
+	// 비교할 파일 선택 조건 (예: min_size로 작은 파일 제외)
+	FileFilter
+
+	// 중복을 찾을 범위 - depth 폴더 아래의 모든 파일
+	TargetScope
+}
+
+type DedupeLog struct {
+	Groups         []DedupeGroupLog
+	DuplicateBytes int64
+	Action         string
+	TotalFiles     int
+}
+
+type DedupeGroupLog struct {
+	Kept       string
+	Duplicates []string
+	Size       int64
+}
+
+func (d *Dedupe) Process(cfg *config.Config) error {
+	totalProcessed := 0
+
+	// 설정 구조체
+	var pluginConfig DedupeConfig
+
+	// Config 파싱
+	if d.pluginCfg != nil && len(d.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(d.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	switch pluginConfig.Keep {
+	case "":
+		pluginConfig.Keep = KeepOldest
+	case KeepOldest, KeepNewest, KeepShortestPath:
+	case KeepPreferredFolder:
+		if len(pluginConfig.PreferredFolders) == 0 {
+			return fmt.Errorf("preferred_folders is required for keep: preferred_folder")
+		}
+	default:
+		return fmt.Errorf("unknown keep policy: %s", pluginConfig.Keep)
+	}
+
+	switch pluginConfig.Action {
+	case "":
+		pluginConfig.Action = DedupeReport
+	case DedupeDelete, DedupeHardlink, DedupeReport:
+	default:
+		return fmt.Errorf("unknown dedupe action: %s", pluginConfig.Action)
+	}
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	log := &DedupeLog{Action: pluginConfig.Action}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	// 모든 작업 경로의 파일을 모아서 한 번에 비교 (depth 범위가 겹쳐도 한 번만)
+	var paths []string
+	seen := make(map[string]bool)
+	for _, dir := range workDirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && !seen[path] && filter.match(path, d) {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	groups, err := utils.FindDuplicates(paths, true)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		kept, err := selectKeeper(group.Paths, cfg.WorkPath, pluginConfig)
+		if err != nil {
+			return err
+		}
+
+		groupLog := DedupeGroupLog{Kept: kept, Size: group.Size}
+		for _, path := range group.Paths {
+			if path == kept {
+				continue
+			}
+
+			if err := removeDuplicate(path, kept, pluginConfig.Action); err != nil {
+				return err
+			}
+			groupLog.Duplicates = append(groupLog.Duplicates, path)
+			totalProcessed++
+		}
+
+		log.Groups = append(log.Groups, groupLog)
+		log.DuplicateBytes += group.DuplicateBytes()
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("dedupe_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeDedupeLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// selectKeeper: keep 정책에 따라 남길 파일을 고른다. 기준이 같으면 경로 순서가 앞선 파일을 남긴다.
+func selectKeeper(paths []string, workPath string, pluginConfig DedupeConfig) (string, error) {
+	type candidate struct {
+		path    string
+		modTime time.Time
+		rank    int // preferred_folders 순서 (없으면 len)
+	}
+
+	candidates := make([]candidate, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", err
+		}
+
+		rank := len(pluginConfig.PreferredFolders)
+		rel, _ := filepath.Rel(workPath, path)
+		for i, folder := range pluginConfig.PreferredFolders {
+			folder = filepath.Clean(folder)
+			if rel == folder || strings.HasPrefix(rel, folder+string(os.PathSeparator)) {
+				rank = i
+				break
+			}
+		}
+
+		candidates = append(candidates, candidate{path: path, modTime: info.ModTime(), rank: rank})
+	}
+
+	best := slices.MinFunc(candidates, func(a, b candidate) int {
+		var c int
+		switch pluginConfig.Keep {
+		case KeepOldest:
+			c = a.modTime.Compare(b.modTime)
+		case KeepNewest:
+			c = b.modTime.Compare(a.modTime)
+		case KeepShortestPath:
+			c = len(a.path) - len(b.path)
+		case KeepPreferredFolder:
+			c = a.rank - b.rank
+			if c == 0 {
+				c = len(a.path) - len(b.path) // 선호 폴더가 같으면 짧은 경로
+			}
+		}
+		if c != 0 {
+			return c
+		}
+		return strings.Compare(a.path, b.path)
+	})
+
+	return best.path, nil
+}
+
+// removeDuplicate: action에 따라 중복 파일을 처리한다.
+func removeDuplicate(path, kept, action string) error {
+	switch action {
+	case DedupeDelete:
+		return os.Remove(path)
+	case DedupeHardlink:
+		// 이미 같은 파일(하드링크)이면 스킵
+		keptInfo, keptErr := os.Stat(kept)
+		info, err := os.Stat(path)
+		if keptErr == nil && err == nil && os.SameFile(keptInfo, info) {
+			return nil
+		}
+
+		// 임시 이름으로 링크를 만든 뒤 교체 - 실패해도 원본 파일이 남는다
+		tempPath := path + ".fm-link"
+		if err := os.Link(kept, tempPath); err != nil {
+			return fmt.Errorf("hardlink failed for %s: %v", path, err)
+		}
+		if err := os.Rename(tempPath, path); err != nil {
+			os.Remove(tempPath)
+			return fmt.Errorf("hardlink failed for %s: %v", path, err)
+		}
+		return nil
+	default: // report
+		return nil
+	}
+}
+
+func writeDedupeLogFile(log *DedupeLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Dedupe Processing Log\n")
+	fmt.Fprintf(file, "Action: %s\n", log.Action)
+	fmt.Fprintf(file, "Total files processed: %d\n", log.TotalFiles)
+	fmt.Fprintf(file, "Duplicate bytes: %d\n\n", log.DuplicateBytes)
+
+	fmt.Fprintf(file, "=== DUPLICATE GROUPS ===\n")
+	for _, group := range log.Groups {
+		fmt.Fprintf(file, "KEPT: %s (%d bytes)\n", group.Kept, group.Size)
+		for _, duplicate := range group.Duplicates {
+			fmt.Fprintf(file, "  DUPLICATE: %s\n", duplicate)
+		}
+	}
+
+	return nil
+}
+
+func (d *Dedupe) GetName() string {
+	return "DEDUPE"
+}
+
+func (d *Dedupe) GetDescription() string {
+	return "타겟 폴더에서 내용이 같은 파일을 찾아 하나만 남깁니다. " +
+		"나머지는 삭제, 하드링크로 교체 또는 보고만 합니다."
+}
@@ -0,0 +1,73 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSelectKeeper(t *testing.T) {
+	work := t.TempDir()
+	now := time.Now()
+	paths := []string{
+		filepath.Join(work, "a", "deep", "report.pdf"), // 가장 오래됨
+		filepath.Join(work, "b", "report.pdf"),         // 가장 최근
+		filepath.Join(work, "c", "report.pdf"),
+	}
+	for i, offset := range []time.Duration{0, time.Hour, time.Minute} {
+		os.MkdirAll(filepath.Dir(paths[i]), 0755)
+		os.WriteFile(paths[i], []byte("same"), 0644)
+		os.Chtimes(paths[i], now.Add(offset), now.Add(offset))
+	}
+
+	tests := []struct {
+		pluginConfig DedupeConfig
+		expected     string
+	}{
+		{DedupeConfig{Keep: KeepOldest}, paths[0]},
+		{DedupeConfig{Keep: KeepNewest}, paths[1]},
+		{DedupeConfig{Keep: KeepShortestPath}, paths[1]}, // 길이가 같으면 경로 순
+		{DedupeConfig{Keep: KeepPreferredFolder, PreferredFolders: []string{"c", "a"}}, paths[2]},
+	}
+
+	for _, tc := range tests {
+		got, err := selectKeeper(paths, work, tc.pluginConfig)
+		if err != nil || got != tc.expected {
+			t.Errorf("keep %s: expected %s, got %s (%v)", tc.pluginConfig.Keep, tc.expected, got, err)
+		}
+	}
+}
+
+func TestRemoveDuplicate(t *testing.T) {
+	for _, action := range []string{DedupeDelete, DedupeHardlink, DedupeReport} {
+		t.Run(action, func(t *testing.T) {
+			dir := t.TempDir()
+			kept := filepath.Join(dir, "kept.txt")
+			duplicate := filepath.Join(dir, "duplicate.txt")
+			os.WriteFile(kept, []byte("same"), 0644)
+			os.WriteFile(duplicate, []byte("same"), 0644)
+
+			if err := removeDuplicate(duplicate, kept, action); err != nil {
+				t.Fatalf("removeDuplicate failed: %v", err)
+			}
+
+			keptInfo, _ := os.Stat(kept)
+			info, err := os.Stat(duplicate)
+			switch action {
+			case DedupeDelete:
+				if !os.IsNotExist(err) {
+					t.Errorf("expected duplicate deleted")
+				}
+			case DedupeHardlink:
+				if err != nil || !os.SameFile(keptInfo, info) {
+					t.Errorf("expected duplicate replaced by hardlink")
+				}
+			case DedupeReport:
+				if err != nil || os.SameFile(keptInfo, info) {
+					t.Errorf("expected duplicate left untouched")
+				}
+			}
+		})
+	}
+}
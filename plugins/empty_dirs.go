@@ -0,0 +1,112 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type EmptyDirs struct {
+	pluginCfg *config.PluginConfig
+}
+
+// EmptyDirs 플러그인의 설정값 구조체
+type EmptyDirsConfig struct {
+	ExcludeDirs []string `json:"exclude_dirs,omitempty"` // 삭제하지 않을 폴더 (이름 또는 depth 폴더 기준 상대 경로, glob 가능)
+
+	// 정리할 타겟 폴더와 깊이 - depth 폴더 자체는 삭제하지 않음
+	TargetScope
+}
+
+type EmptyDirsLog struct {
+	RemovedDirs []string
+	TotalDirs   int
+}
+
+func (e *EmptyDirs) Process(cfg *config.Config) error {
+	log := &EmptyDirsLog{}
+
+	// 설정 구조체
+	var pluginConfig EmptyDirsConfig
+
+	// Config 파싱
+	if e.pluginCfg != nil && len(e.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(e.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := removeEmptyWorkDirs(workDirs, pluginConfig, log); err != nil {
+		return err
+	}
+
+	log.TotalDirs = len(log.RemovedDirs)
+
+	logFileName := fmt.Sprintf("empty_dirs_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeEmptyDirsLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// removeEmptyWorkDirs: 작업 경로마다 빈 하위 폴더를 삭제한다.
+// depth 범위가 겹치면 앞의 작업 경로에서 이미 삭제된 폴더는 건너뛴다.
+func removeEmptyWorkDirs(workDirs []string, pluginConfig EmptyDirsConfig, log *EmptyDirsLog) error {
+	for _, dir := range workDirs {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+
+		removed, err := utils.RemoveEmptyDirs(dir, pluginConfig.ExcludeDirs)
+		log.RemovedDirs = append(log.RemovedDirs, removed...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeEmptyDirsLogFile(log *EmptyDirsLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager EmptyDirs Processing Log\n")
+	fmt.Fprintf(file, "Total dirs removed: %d\n\n", log.TotalDirs)
+
+	fmt.Fprintf(file, "=== REMOVED DIRS ===\n")
+	for _, removed := range log.RemovedDirs {
+		fmt.Fprintf(file, "REMOVED: %s\n", removed)
+	}
+
+	return nil
+}
+
+func (e *EmptyDirs) GetName() string {
+	return "EMPTY_DIRS"
+}
+
+func (e *EmptyDirs) GetDescription() string {
+	return "비어 있는 폴더를 하위 폴더부터 삭제합니다. " +
+		"exclude_dirs에 지정한 폴더는 비어 있어도 남깁니다."
+}
@@ -0,0 +1,38 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRemoveEmptyWorkDirs(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "a", "b", "c"), 0755)
+	os.MkdirAll(filepath.Join(dir, "keep", "empty"), 0755)
+	os.MkdirAll(filepath.Join(dir, "files", "empty"), 0755)
+	os.WriteFile(filepath.Join(dir, "files", "doc.txt"), nil, 0644)
+
+	// 부모 작업 경로에서 a/b가 먼저 삭제되어도 실패하지 않아야 함
+	workDirs := []string{dir, filepath.Join(dir, "a", "b")}
+	pluginConfig := EmptyDirsConfig{ExcludeDirs: []string{"keep"}}
+	log := &EmptyDirsLog{}
+
+	if err := removeEmptyWorkDirs(workDirs, pluginConfig, log); err != nil {
+		t.Fatalf("removeEmptyWorkDirs failed: %v", err)
+	}
+
+	for _, name := range []string{"a", "files/empty", "keep/empty"} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); !os.IsNotExist(err) {
+			t.Errorf("expected %s removed", name)
+		}
+	}
+	for _, name := range []string{"keep", "files"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("expected %s kept: %v", name, err)
+		}
+	}
+	if len(log.RemovedDirs) != 5 {
+		t.Errorf("expected 5 removed dirs, got %v", log.RemovedDirs)
+	}
+}
@@ -0,0 +1,278 @@
+package plugins
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Encoding struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 줄바꿈 변환
+const (
+	LineEndingKeep = ""     // 그대로 (기본값)
+	LineEndingLF   = "lf"   // \n
+	LineEndingCRLF = "crlf" // \r\n
+)
+
+var (
+	defaultEncodingExtensions = []string{"txt", "csv"}
+	defaultSourceEncodings    = []string{"cp949"}
+)
+
+// Encoding 플러그인의 설정값 구조체
+type EncodingConfig struct {
+	SourceEncodings []string `json:"source_encodings,omitempty"` // UTF-8이 아닐 때 시도할 인코딩 (기본 cp949)
+	AddBOM          bool     `json:"add_bom"`                    // UTF-8 BOM 추가 (Excel에서 CSV 열기용)
+	RemoveBOM       bool     `json:"remove_bom"`                 // 기존 BOM 제거 (없으면 원래 있던 BOM 유지)
+	LineEndings     string   `json:"line_endings,omitempty"`     // lf, crlf
+	SearchSubdirs   bool     `json:"search_subdirs"`             // 하위 폴더까지 검색 여부
+
+	// 검사할 파일 선택 조건 (file_extensions 기본 txt, csv)
+	FileFilter
+
+	// 변환할 타겟 폴더와 깊이
+	TargetScope
+}
+
+type EncodingLog struct {
+	ConvertedFiles map[string]string // 경로 -> 원래 인코딩
+	UncertainFiles []string          // 판별하지 못해 변환하지 않은 파일과 이유
+	BinaryFiles    []string          // 바이너리라 건너뛴 파일
+	TotalFiles     int
+}
+
+func (e *Encoding) Process(cfg *config.Config) error {
+	totalProcessed := 0
+	log := &EncodingLog{
+		ConvertedFiles: make(map[string]string),
+	}
+
+	// 설정 구조체
+	var pluginConfig EncodingConfig
+
+	// Config 파싱
+	if e.pluginCfg != nil && len(e.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(e.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	if len(pluginConfig.FileExtensions) == 0 {
+		pluginConfig.FileExtensions = defaultEncodingExtensions
+	}
+	if len(pluginConfig.SourceEncodings) == 0 {
+		pluginConfig.SourceEncodings = defaultSourceEncodings
+	}
+	for _, name := range pluginConfig.SourceEncodings {
+		if _, err := utils.LookupEncoding(name); err != nil {
+			return err
+		}
+	}
+
+	if pluginConfig.AddBOM && pluginConfig.RemoveBOM {
+		return fmt.Errorf("add_bom and remove_bom cannot be used together")
+	}
+
+	switch pluginConfig.LineEndings {
+	case LineEndingKeep, LineEndingLF, LineEndingCRLF:
+	default:
+		return fmt.Errorf("unknown line_endings: %s", pluginConfig.LineEndings)
+	}
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, dir := range workDirs {
+		err := walkFiles(dir, pluginConfig.SearchSubdirs, func(path string) error {
+			if seen[path] || !filter.match(path, nil) {
+				return nil
+			}
+			seen[path] = true
+
+			converted, err := convertFileEncoding(path, pluginConfig, log)
+			if converted {
+				totalProcessed++
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("encoding_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeEncodingLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// walkFiles: dir의 파일마다 fn을 호출한다. searchSubdirs면 하위 폴더까지
+func walkFiles(dir string, searchSubdirs bool, fn func(path string) error) error {
+	if searchSubdirs {
+		return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			return fn(path)
+		})
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if err := fn(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertFileEncoding: 파일을 UTF-8로 변환해 다시 쓴다. 내용이 바뀌지 않으면 쓰지 않는다.
+func convertFileEncoding(path string, pluginConfig EncodingConfig, log *EncodingLog) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	detected := utils.DetectEncoding(data, pluginConfig.SourceEncodings)
+	if detected.Binary {
+		log.BinaryFiles = append(log.BinaryFiles, path)
+		return false, nil
+	}
+	if !detected.Confident {
+		log.UncertainFiles = append(log.UncertainFiles, fmt.Sprintf("%s: %s", path, detected.Reason))
+		return false, nil
+	}
+
+	text, err := decodeText(data, detected)
+	if err != nil {
+		log.UncertainFiles = append(log.UncertainFiles, fmt.Sprintf("%s: %v", path, err))
+		return false, nil
+	}
+
+	switch pluginConfig.LineEndings {
+	case LineEndingLF:
+		text = bytes.ReplaceAll(text, []byte("\r\n"), []byte("\n"))
+	case LineEndingCRLF:
+		text = bytes.ReplaceAll(text, []byte("\r\n"), []byte("\n"))
+		text = bytes.ReplaceAll(text, []byte("\n"), []byte("\r\n"))
+	}
+	// 원래 BOM이 있던 파일(UTF-8, UTF-16)은 remove_bom이 아니면 UTF-8 BOM을 유지
+	if pluginConfig.AddBOM || (detected.BOMLength > 0 && !pluginConfig.RemoveBOM) {
+		text = append([]byte{0xEF, 0xBB, 0xBF}, text...)
+	}
+
+	if bytes.Equal(text, data) {
+		return false, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+
+	// 임시 파일에 쓴 뒤 교체 - 실패해도 원본 파일이 남는다
+	tempPath := path + ".fm-encoding"
+	if err := os.WriteFile(tempPath, text, info.Mode().Perm()); err != nil {
+		os.Remove(tempPath)
+		return false, err
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return false, err
+	}
+
+	log.ConvertedFiles[path] = detected.Name
+	return true, nil
+}
+
+// decodeText: 판별한 인코딩의 내용을 BOM 없는 UTF-8로 변환한다. BOM은 호출하는 쪽에서 설정에 따라 붙인다.
+func decodeText(data []byte, detected utils.TextEncoding) ([]byte, error) {
+	switch detected.Name {
+	case utils.EncodingUTF8:
+		return data[detected.BOMLength:], nil
+	case utils.EncodingUTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder().Bytes(data[detected.BOMLength:])
+	case utils.EncodingUTF16BE:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewDecoder().Bytes(data[detected.BOMLength:])
+	default:
+		enc, err := utils.LookupEncoding(detected.Name)
+		if err != nil {
+			return nil, err
+		}
+		return enc.NewDecoder().Bytes(data)
+	}
+}
+
+func writeEncodingLogFile(log *EncodingLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Encoding Processing Log\n")
+	fmt.Fprintf(file, "Total files converted: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== CONVERTED FILES ===\n")
+	for path, from := range log.ConvertedFiles {
+		fmt.Fprintf(file, "CONVERTED: %s (%s -> utf-8)\n", path, from)
+	}
+
+	fmt.Fprintf(file, "\n=== UNCERTAIN FILES (NOT CONVERTED) ===\n")
+	for _, uncertain := range log.UncertainFiles {
+		fmt.Fprintf(file, "UNCERTAIN: %s\n", uncertain)
+	}
+
+	fmt.Fprintf(file, "\n=== BINARY FILES (SKIPPED) ===\n")
+	for _, binary := range log.BinaryFiles {
+		fmt.Fprintf(file, "BINARY: %s\n", binary)
+	}
+
+	return nil
+}
+
+func (e *Encoding) GetName() string {
+	return "ENCODING"
+}
+
+func (e *Encoding) GetDescription() string {
+	return "텍스트 파일의 인코딩(BOM, UTF-8, CP949 등)을 판별해 UTF-8로 변환합니다. " +
+		"바이너리 파일은 건너뛰고, 판별하지 못한 파일은 로그에 기록합니다."
+}
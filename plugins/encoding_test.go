@@ -0,0 +1,72 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/text/encoding/korean"
+)
+
+func TestConvertFileEncoding(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "scores.csv")
+	cp949, _ := korean.EUCKR.NewEncoder().Bytes([]byte("이름,점수\r\n홍길동,90\r\n"))
+	os.WriteFile(path, cp949, 0644)
+
+	pluginConfig := EncodingConfig{
+		SourceEncodings: []string{"cp949"},
+		AddBOM:          true,
+		LineEndings:     LineEndingLF,
+	}
+	log := &EncodingLog{ConvertedFiles: make(map[string]string)}
+
+	converted, err := convertFileEncoding(path, pluginConfig, log)
+	if err != nil || !converted {
+		t.Fatalf("convertFileEncoding failed: %v, %v", converted, err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if expected := "\ufeff이름,점수\n홍길동,90\n"; string(data) != expected {
+		t.Errorf("expected %q, got %q", expected, data)
+	}
+
+	// 이미 변환된 파일은 다시 쓰지 않음
+	if converted, _ := convertFileEncoding(path, pluginConfig, log); converted {
+		t.Errorf("expected no conversion for already converted file")
+	}
+}
+
+func TestConvertFileEncodingKeepsBOM(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   EncodingConfig
+		expected string
+	}{
+		{"keep existing bom", EncodingConfig{LineEndings: LineEndingLF}, "\ufeffa,b\n"},
+		{"remove bom", EncodingConfig{LineEndings: LineEndingLF, RemoveBOM: true}, "a,b\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "excel.csv")
+			os.WriteFile(path, []byte("\ufeffa,b\r\n"), 0644)
+
+			log := &EncodingLog{ConvertedFiles: make(map[string]string)}
+			if _, err := convertFileEncoding(path, tc.config, log); err != nil {
+				t.Fatalf("convertFileEncoding failed: %v", err)
+			}
+			if data, _ := os.ReadFile(path); string(data) != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, data)
+			}
+		})
+	}
+
+	// 바꿀 것이 없으면 BOM이 있는 파일을 다시 쓰지 않음
+	path := filepath.Join(t.TempDir(), "excel.csv")
+	os.WriteFile(path, []byte("\ufeffa,b\r\n"), 0644)
+	log := &EncodingLog{ConvertedFiles: make(map[string]string)}
+	if converted, _ := convertFileEncoding(path, EncodingConfig{}, log); converted || len(log.ConvertedFiles) != 0 {
+		t.Errorf("expected utf-8 file with bom to be left untouched, got %v", log.ConvertedFiles)
+	}
+}
@@ -0,0 +1,239 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Extensions struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 내용 확인(sniff) 방식
+const (
+	SniffOff      = "off"      // 확인하지 않음 (기본값)
+	SniffMissing  = "missing"  // 확장자가 없는 파일에만 확장자 추가
+	SniffMismatch = "mismatch" // 확장자가 내용과 다른 파일도 수정
+)
+
+// 기본 확장자 변환표 (canonicalize: true)
+var canonicalExtensions = map[string]string{
+	"jpeg":     "jpg",
+	"jpe":      "jpg",
+	"tif":      "tiff",
+	"htm":      "html",
+	"yml":      "yaml",
+	"mpeg":     "mpg",
+	"markdown": "md",
+}
+
+// Extensions 플러그인의 설정값 구조체
+type ExtensionsConfig struct {
+	Lowercase     bool              `json:"lowercase"`         // 확장자를 소문자로 (REPORT.PDF -> REPORT.pdf)
+	Canonicalize  bool              `json:"canonicalize"`      // 기본 변환표 적용 (jpeg -> jpg 등)
+	Mapping       map[string]string `json:"mapping,omitempty"` // 추가 변환표 (점 제외, 기본 변환표보다 우선)
+	Sniff         string            `json:"sniff,omitempty"`   // off, missing, mismatch
+	SearchSubdirs bool              `json:"search_subdirs"`    // 하위 폴더까지 검색 여부
+
+	// 대상 파일 선택 조건
+	FileFilter
+
+	// 정리할 타겟 폴더와 깊이
+	TargetScope
+}
+
+type ExtensionsLog struct {
+	RenamedFiles map[string]string // 원본경로 -> 새경로
+	Reasons      map[string]string // 원본경로 -> 변경 이유
+	Collisions   []string          // 충돌로 변경하지 않은 항목
+	TotalFiles   int
+}
+
+func (e *Extensions) Process(cfg *config.Config) error {
+	log := &ExtensionsLog{
+		RenamedFiles: make(map[string]string),
+		Reasons:      make(map[string]string),
+	}
+
+	// 설정 구조체
+	var pluginConfig ExtensionsConfig
+
+	// Config 파싱
+	if e.pluginCfg != nil && len(e.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(e.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	switch pluginConfig.Sniff {
+	case "":
+		pluginConfig.Sniff = SniffOff
+	case SniffOff, SniffMissing, SniffMismatch:
+	default:
+		return fmt.Errorf("unknown sniff mode: %s", pluginConfig.Sniff)
+	}
+
+	mapping := extensionMapping(pluginConfig)
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	// 전체 변경 목록을 만든 뒤 한 번에 충돌 검사
+	var pairs []utils.RenamePair
+	seen := make(map[string]bool)
+	for _, dir := range workDirs {
+		err := walkFiles(dir, pluginConfig.SearchSubdirs, func(path string) error {
+			if seen[path] || !filter.match(path, nil) {
+				return nil
+			}
+			seen[path] = true
+
+			newName, reason, err := fixExtension(path, pluginConfig, mapping)
+			if err != nil {
+				return err
+			}
+			if newName != filepath.Base(path) {
+				newPath := filepath.Join(filepath.Dir(path), newName)
+				pairs = append(pairs, utils.RenamePair{From: path, To: newPath})
+				log.Reasons[path] = reason
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	valid, conflicts := utils.PlanRenames(pairs)
+	log.Collisions = append(log.Collisions, conflicts...)
+
+	done, err := utils.ApplyRenames(valid)
+	for _, rename := range done {
+		log.RenamedFiles[rename.From] = rename.To
+	}
+	if err != nil {
+		return err
+	}
+
+	log.TotalFiles = len(valid)
+
+	logFileName := fmt.Sprintf("extensions_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeExtensionsLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// extensionMapping: 기본 변환표와 mapping을 합친 변환표 (키는 소문자, 점 제외)
+func extensionMapping(pluginConfig ExtensionsConfig) map[string]string {
+	mapping := make(map[string]string)
+	if pluginConfig.Canonicalize {
+		for from, to := range canonicalExtensions {
+			mapping[from] = to
+		}
+	}
+	for from, to := range pluginConfig.Mapping {
+		mapping[strings.TrimPrefix(utils.NormalizeExt(from), ".")] = strings.TrimPrefix(to, ".")
+	}
+	return mapping
+}
+
+// fixExtension: 설정에 따라 바꿀 파일명과 이유를 반환한다. 바꿀 필요가 없으면 원래 이름
+func fixExtension(path string, pluginConfig ExtensionsConfig, mapping map[string]string) (string, string, error) {
+	name := filepath.Base(path)
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	if stem == "" {
+		// .gitignore 같은 점 파일은 확장자가 없는 것으로 본다
+		stem, ext = name, ""
+	}
+	newExt := strings.TrimPrefix(ext, ".")
+	var reasons []string
+
+	if pluginConfig.Sniff != SniffOff && (newExt == "" || pluginConfig.Sniff == SniffMismatch) {
+		kind, ok, err := utils.SniffFile(path)
+		if err != nil {
+			return "", "", err
+		}
+		switch {
+		case !ok:
+		case newExt == "":
+			newExt = kind.Ext
+			reasons = append(reasons, "added from content")
+		case kind.Container:
+			// zip, OLE 같은 공용 시그니처만으로는 확장자가 틀렸다고 단정하지 않음
+		case !kind.Matches(newExt) && !kind.Matches(mapping[strings.ToLower(newExt)]):
+			reasons = append(reasons, fmt.Sprintf("content is %s, not %s", kind.Ext, newExt))
+			newExt = kind.Ext
+		}
+	}
+
+	if mapped, ok := mapping[strings.ToLower(newExt)]; ok && !strings.EqualFold(mapped, newExt) {
+		reasons = append(reasons, fmt.Sprintf("mapped %s -> %s", newExt, mapped))
+		newExt = mapped
+	}
+
+	if pluginConfig.Lowercase && newExt != strings.ToLower(newExt) {
+		newExt = strings.ToLower(newExt)
+		reasons = append(reasons, "lowercase")
+	}
+
+	if newExt == "" {
+		return name, "", nil
+	}
+	return stem + "." + newExt, strings.Join(reasons, ", "), nil
+}
+
+func writeExtensionsLogFile(log *ExtensionsLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Extensions Processing Log\n")
+	fmt.Fprintf(file, "Total files processed: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== RENAMED FILES ===\n")
+	for original, renamed := range log.RenamedFiles {
+		fmt.Fprintf(file, "RENAMED: %s -> %s (%s)\n", original, renamed, log.Reasons[original])
+	}
+
+	fmt.Fprintf(file, "\n=== COLLISIONS ===\n")
+	for _, collision := range log.Collisions {
+		fmt.Fprintf(file, "COLLISION: %s\n", collision)
+	}
+
+	return nil
+}
+
+func (e *Extensions) GetName() string {
+	return "EXTENSIONS"
+}
+
+func (e *Extensions) GetDescription() string {
+	return "확장자를 소문자와 표준 이름(jpeg -> jpg 등)으로 통일합니다. " +
+		"파일 내용을 확인해 없는 확장자를 추가하거나 잘못된 확장자를 고칠 수 있습니다."
+}
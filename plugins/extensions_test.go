@@ -0,0 +1,70 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFixExtension(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"REPORT.PDF":  []byte("%PDF-1.7"),
+		"photo.jpeg":  {0xFF, 0xD8, 0xFF, 0xE0},
+		"scan":        []byte("\x89PNG\r\n\x1a\n"),
+		"image.pdf":   []byte("\x89PNG\r\n\x1a\n"),
+		"notes":       []byte("plain text"),
+		"report.docx": []byte("PK\x03\x04"),
+		"data.dat":    []byte("x"),
+		"book.xlsm":   []byte("PK\x03\x04"),
+		"setup.msi":   {0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1},
+		"photo.avif":  []byte("\x00\x00\x00\x1cftypavif"),
+		"BMI.csv":     []byte("BMI,weight,height\n22.5,70,176\n"),
+		"README.md":   []byte("<p align=\"center\">\n  <img src=\"logo.png\">\n</p>\n"),
+		"icon.svg":    []byte("<!-- Generator: Adobe Illustrator -->\n<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>\n"),
+		"index.php":   []byte("<!DOCTYPE html>\n<html><?php echo 1; ?></html>\n"),
+		"page":        []byte("<!DOCTYPE html>\n<html></html>\n"),
+	}
+	for name, content := range files {
+		os.WriteFile(filepath.Join(dir, name), content, 0644)
+	}
+
+	pluginConfig := ExtensionsConfig{
+		Lowercase:    true,
+		Canonicalize: true,
+		Mapping:      map[string]string{".dat": "bin"},
+		Sniff:        SniffMismatch,
+	}
+	mapping := extensionMapping(pluginConfig)
+
+	expected := map[string]string{
+		"REPORT.PDF":  "REPORT.pdf",
+		"photo.jpeg":  "photo.jpg",
+		"scan":        "scan.png",
+		"image.pdf":   "image.png",
+		"notes":       "notes",
+		"report.docx": "report.docx",
+		"data.dat":    "data.bin",
+		// 공용 컨테이너 시그니처나 짧은 매직 넘버로는 바꾸지 않음
+		"book.xlsm":  "book.xlsm",
+		"setup.msi":  "setup.msi",
+		"photo.avif": "photo.avif",
+		"BMI.csv":    "BMI.csv",
+		"README.md":  "README.md",
+		"icon.svg":   "icon.svg",
+		"index.php":  "index.php",
+		"page":       "page.html", // 확장자가 없을 때만 추가
+	}
+	for name, want := range expected {
+		got, _, err := fixExtension(filepath.Join(dir, name), pluginConfig, mapping)
+		if err != nil || got != want {
+			t.Errorf("%s: expected %s, got %s (%v)", name, want, got, err)
+		}
+	}
+
+	// sniff: missing이면 잘못된 확장자는 그대로
+	pluginConfig.Sniff = SniffMissing
+	if got, _, _ := fixExtension(filepath.Join(dir, "image.pdf"), pluginConfig, mapping); got != "image.pdf" {
+		t.Errorf("sniff missing: expected image.pdf, got %s", got)
+	}
+}
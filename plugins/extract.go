@@ -0,0 +1,280 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Extract struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 압축 해제 기본 제한 (zip bomb 방지)
+const (
+	defaultExtractEncoding  = "cp949"
+	defaultExtractMaxSizeMB = 1024
+	defaultExtractMaxFiles  = 10000
+	defaultExtractMaxRatio  = 100
+)
+
+// Extract 플러그인의 설정값 구조체
+type ExtractConfig struct {
+	LegacyEncoding string `json:"legacy_encoding,omitempty"` // UTF-8이 아닌 파일명의 인코딩 (기본 cp949)
+	SearchSubdirs  bool   `json:"search_subdirs"`            // 하위 폴더까지 검색 여부
+	DeleteArchive  bool   `json:"delete_archive"`            // 압축 해제 후 압축 파일 삭제
+
+	// 압축 파일 하나당 제한
+	MaxSizeMB int   `json:"max_size_mb,omitempty"` // 풀린 파일 전체 크기 (기본 1024MB)
+	MaxFiles  int   `json:"max_files,omitempty"`   // 파일 개수 (기본 10000)
+	MaxRatio  int64 `json:"max_ratio,omitempty"`   // zip 항목 압축률 (기본 100배)
+
+	// 압축 파일을 찾을 타겟 폴더와 깊이
+	TargetScope
+}
+
+type ExtractLog struct {
+	Extracted    map[string]string // 압축 파일 -> 풀린 폴더
+	DecodedNames []string          // legacy_encoding으로 변환한 파일명
+	Failed       []string          // 실패한 압축 파일과 이유
+	TotalFiles   int
+}
+
+func (e *Extract) Process(cfg *config.Config) error {
+	totalProcessed := 0
+	log := &ExtractLog{
+		Extracted: make(map[string]string),
+	}
+
+	// 설정 구조체
+	var pluginConfig ExtractConfig
+
+	// Config 파싱
+	if e.pluginCfg != nil && len(e.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(e.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	if pluginConfig.LegacyEncoding == "" {
+		pluginConfig.LegacyEncoding = defaultExtractEncoding
+	}
+	if pluginConfig.MaxSizeMB == 0 {
+		pluginConfig.MaxSizeMB = defaultExtractMaxSizeMB
+	}
+	if pluginConfig.MaxFiles == 0 {
+		pluginConfig.MaxFiles = defaultExtractMaxFiles
+	}
+	if pluginConfig.MaxRatio == 0 {
+		pluginConfig.MaxRatio = defaultExtractMaxRatio
+	}
+
+	legacy, err := utils.LookupEncoding(pluginConfig.LegacyEncoding)
+	if err != nil {
+		return err
+	}
+
+	opts := utils.ExtractOptions{
+		MaxTotalSize: int64(pluginConfig.MaxSizeMB) << 20,
+		MaxEntries:   pluginConfig.MaxFiles,
+		MaxRatio:     pluginConfig.MaxRatio,
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	// 풀린 파일 안의 압축 파일은 다시 풀지 않도록 목록을 먼저 만든다
+	archives, err := findArchives(workDirs, pluginConfig.SearchSubdirs)
+	if err != nil {
+		return err
+	}
+
+	totalProcessed, err = extractArchives(archives, opts, legacy.NewDecoder(), pluginConfig.DeleteArchive, log)
+	if err != nil {
+		return err
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("extract_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeExtractLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// extractArchives: 압축 파일을 차례로 푼다. 실패한 압축 파일은 로그에 기록하고 다음 파일을 푼다.
+// legacy 인코딩으로 변환한 파일명은 압축을 모두 푼 경우에만 기록한다.
+func extractArchives(archives []string, opts utils.ExtractOptions, decoder *encoding.Decoder, deleteArchive bool, log *ExtractLog) (int, error) {
+	processFileCount := 0
+
+	var decodedNames []string
+	// UTF-8이 아닌 이름만 변환 (Windows 한글 zip의 CP949 파일명)
+	opts.DecodeName = func(name string) string {
+		if utf8.ValidString(name) {
+			return name
+		}
+		decoded, err := decoder.String(name)
+		if err != nil {
+			return name
+		}
+		decodedNames = append(decodedNames, decoded)
+		return decoded
+	}
+
+	for _, archivePath := range archives {
+		decodedNames = nil
+		destDir, count, err := extractArchive(archivePath, opts)
+		if err != nil {
+			log.Failed = append(log.Failed, fmt.Sprintf("%s: %v", archivePath, err))
+			continue
+		}
+
+		log.Extracted[archivePath] = destDir
+		log.DecodedNames = append(log.DecodedNames, decodedNames...)
+		processFileCount += count
+
+		if deleteArchive {
+			if err := os.Remove(archivePath); err != nil {
+				return processFileCount, err
+			}
+		}
+	}
+
+	return processFileCount, nil
+}
+
+// findArchives: 작업 경로에서 지원하는 압축 파일(zip, tar, tar.gz, tgz)을 찾는다.
+func findArchives(workDirs []string, searchSubdirs bool) ([]string, error) {
+	var archives []string
+	seen := make(map[string]bool)
+
+	add := func(path string) {
+		if !seen[path] && utils.ArchiveFormat(path) != "" {
+			seen[path] = true
+			archives = append(archives, path)
+		}
+	}
+
+	for _, dir := range workDirs {
+		if searchSubdirs {
+			err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+				add(path)
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				add(filepath.Join(dir, entry.Name()))
+			}
+		}
+	}
+
+	return archives, nil
+}
+
+// extractArchive: 압축 파일을 같은 위치의 폴더(압축 파일 이름)에 푼다.
+// 실패하면 만들던 폴더를 삭제한다.
+func extractArchive(archivePath string, opts utils.ExtractOptions) (string, int, error) {
+	destDir := extractDirPath(archivePath)
+	if err := os.Mkdir(destDir, 0755); err != nil {
+		return "", 0, err
+	}
+
+	files, err := utils.ExtractArchive(archivePath, utils.ArchiveFormat(archivePath), destDir, opts)
+	if err != nil {
+		os.RemoveAll(destDir)
+		return "", 0, err
+	}
+	return destDir, len(files), nil
+}
+
+// extractDirPath: 압축 파일 이름에서 확장자를 뺀 폴더 경로. 이미 있으면 _1, _2...를 붙인다.
+func extractDirPath(archivePath string) string {
+	name := filepath.Base(archivePath)
+	lower := strings.ToLower(name)
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar", ".zip"} {
+		if strings.HasSuffix(lower, ext) {
+			name = name[:len(name)-len(ext)]
+			break
+		}
+	}
+
+	base := filepath.Join(filepath.Dir(archivePath), name)
+	destDir := base
+	for i := 1; ; i++ {
+		if _, err := os.Stat(destDir); os.IsNotExist(err) {
+			return destDir
+		}
+		destDir = fmt.Sprintf("%s_%d", base, i)
+	}
+}
+
+func writeExtractLogFile(log *ExtractLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Extract Processing Log\n")
+	fmt.Fprintf(file, "Total files extracted: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== EXTRACTED ARCHIVES ===\n")
+	for archive, dest := range log.Extracted {
+		fmt.Fprintf(file, "EXTRACTED: %s -> %s\n", archive, dest)
+	}
+
+	fmt.Fprintf(file, "\n=== DECODED NAMES ===\n")
+	for _, name := range log.DecodedNames {
+		fmt.Fprintf(file, "DECODED: %s\n", name)
+	}
+
+	fmt.Fprintf(file, "\n=== FAILED ARCHIVES ===\n")
+	for _, failed := range log.Failed {
+		fmt.Fprintf(file, "FAILED: %s\n", failed)
+	}
+
+	return nil
+}
+
+func (e *Extract) GetName() string {
+	return "EXTRACT"
+}
+
+func (e *Extract) GetDescription() string {
+	return "zip, tar, tar.gz 파일을 같은 위치의 폴더에 풉니다. " +
+		"UTF-8이 아닌 파일명(CP949 등)을 변환하고, 경로 조작과 과도한 압축 파일을 막습니다."
+}
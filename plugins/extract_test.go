@@ -0,0 +1,86 @@
+package plugins
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/text/encoding/korean"
+
+	"github.com/yek-j/filemanager/utils"
+)
+
+func TestExtractArchiveDecodesLegacyNames(t *testing.T) {
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "과제.zip")
+
+	// 한글 Windows에서 만든 zip처럼 파일명을 CP949로 저장
+	name, _ := korean.EUCKR.NewEncoder().String("보고서.txt")
+	f, _ := os.Create(archivePath)
+	zw := zip.NewWriter(f)
+	w, _ := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	w.Write([]byte("hello"))
+	zw.Close()
+	f.Close()
+
+	legacy, _ := utils.LookupEncoding("cp949")
+	decoder := legacy.NewDecoder()
+	opts := utils.ExtractOptions{DecodeName: func(name string) string {
+		decoded, err := decoder.String(name)
+		if err != nil {
+			return name
+		}
+		return decoded
+	}}
+
+	destDir, count, err := extractArchive(archivePath, opts)
+	if err != nil {
+		t.Fatalf("extractArchive failed: %v", err)
+	}
+	if destDir != filepath.Join(dir, "과제") || count != 1 {
+		t.Errorf("unexpected result: %s, %d", destDir, count)
+	}
+	if _, err := os.Stat(filepath.Join(destDir, "보고서.txt")); err != nil {
+		t.Errorf("decoded file not found: %v", err)
+	}
+
+	// 같은 이름의 폴더가 있으면 번호를 붙임
+	if got := extractDirPath(archivePath); got != filepath.Join(dir, "과제_1") {
+		t.Errorf("unexpected dest dir: %s", got)
+	}
+}
+
+func TestExtractArchivesLogsDecodedNamesOnSuccess(t *testing.T) {
+	dir := t.TempDir()
+	writeZip := func(name string, entries ...string) string {
+		path := filepath.Join(dir, name)
+		f, _ := os.Create(path)
+		zw := zip.NewWriter(f)
+		for _, entry := range entries {
+			encoded, _ := korean.EUCKR.NewEncoder().String(entry)
+			w, _ := zw.CreateHeader(&zip.FileHeader{Name: encoded, Method: zip.Store})
+			w.Write([]byte("x"))
+		}
+		zw.Close()
+		f.Close()
+		return path
+	}
+
+	good := writeZip("good.zip", "보고서.txt")
+	bad := writeZip("bad.zip", "메모.txt", "../탈출.txt") // zip slip으로 실패
+
+	legacy, _ := utils.LookupEncoding("cp949")
+	log := &ExtractLog{Extracted: make(map[string]string)}
+	count, err := extractArchives([]string{good, bad}, utils.ExtractOptions{}, legacy.NewDecoder(), false, log)
+	if err != nil {
+		t.Fatalf("extractArchives failed: %v", err)
+	}
+
+	if count != 1 || len(log.Failed) != 1 {
+		t.Errorf("expected 1 file extracted and 1 failed archive, got %d, %v", count, log.Failed)
+	}
+	if len(log.DecodedNames) != 1 || log.DecodedNames[0] != "보고서.txt" {
+		t.Errorf("expected only names from the extracted archive, got %v", log.DecodedNames)
+	}
+}
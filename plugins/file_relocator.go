@@ -6,8 +6,6 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
-	"slices"
-	"strings"
 	"time"
 
 	"github.com/yek-j/filemanager/config"
@@ -21,24 +19,31 @@ type FileRelocator struct {
 // FileRelocator 플러그인의 설정값 구조체
 type FileRelocatorConfig struct {
 	// 파일 선택
-	FileExtensions []string `json:"file_extensions,omitempty"` // 이동할 파일 확장자
-	FilePattern    string   `json:"file_pattern,omitempty"`    // 파일명 패턴
+	FileFilter
+	FilePattern string `json:"file_pattern,omitempty"` // 파일명 패턴
 
 	// 경로
 	SourceLocation string `json:"source_location"` // 파일 경로
 	TargetLocation string `json:"target_location"` // 이동할 경로
 
 	// 동작 옵션
-	CreateFolder   bool     `json:"create_folder"`   // 이동할 폴더가 없을 때 자동 생성 여부
-	SearchSubdirs  bool     `json:"search_subdirs"`  // 하위 폴더까지 검색 여부
-	OverwriteFiles bool     `json:"overwrite_files"` // 이동할 위치에 이미 파일이 있다면 덮어쓰기 여부
-	TargetFolders  []string `json:"target_folders"`  // 이동할 타켓 폴더
-	UsePattern     bool     `json:"use_pattern"`     // depth 사용 시 false, pattern 사용 시 true
+	CreateFolder   bool `json:"create_folder"`   // 이동할 폴더가 없을 때 자동 생성 여부
+	SearchSubdirs  bool `json:"search_subdirs"`  // 하위 폴더까지 검색 여부
+	OverwriteFiles bool `json:"overwrite_files"` // 이동할 위치에 이미 파일이 있다면 덮어쓰기 여부
+	UsePattern     bool `json:"use_pattern"`     // depth 사용 시 false, pattern 사용 시 true
+
+	// 이동 후 source_location 아래에 남은 빈 폴더 삭제 (source_location도 비면 삭제)
+	RemoveEmptyDirs bool     `json:"remove_empty_dirs"`
+	ExcludeDirs     []string `json:"exclude_dirs,omitempty"` // 빈 폴더라도 삭제하지 않을 폴더
+
+	// 이동할 타겟 폴더와 깊이
+	TargetScope
 }
 
 type FileRelocatorLog struct {
 	MovedFiles  map[string]string // 원본경로 -> 대상경로
 	FailedMoves []string          // 실패한 파일 (전체 경로)
+	RemovedDirs []string          // remove_empty_dirs로 삭제한 폴더
 	TotalFiles  int
 }
 
@@ -59,23 +64,26 @@ func (m *FileRelocator) Process(cfg *config.Config) error {
 		}
 	}
 
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
 	// UsePatter에 따라 작업 방식 분기
 	if pluginConfig.UsePattern {
 		// TODO: usePattern에 따라 작업할 폴더 찾기
 	} else {
-		//
-		for _, targetDir := range pluginConfig.TargetFolders {
-
-			basePath := filepath.Join(cfg.WorkPath, targetDir)
-
-			// 작업할 경로
-			workDirs := utils.GetTargetDirs(basePath, cfg.TargetDepth)
-			for _, dir := range workDirs {
-				count, err := processMoveFiles(dir, pluginConfig, log)
-				totalProcessed += count
-				if err != nil {
-					return err
-				}
+		// 작업할 경로 (target_folders + depth 설정)
+		workDirs, err := pluginConfig.workDirs(cfg)
+		if err != nil {
+			return err
+		}
+
+		for _, dir := range workDirs {
+			count, err := processMoveFiles(dir, pluginConfig, filter, log)
+			totalProcessed += count
+			if err != nil {
+				return err
 			}
 		}
 	}
@@ -84,7 +92,7 @@ func (m *FileRelocator) Process(cfg *config.Config) error {
 
 	logFileName := fmt.Sprintf("file_relocator_log_%s.txt",
 		time.Now().Format("20060102_150405"))
-	logPath := filepath.Join(cfg.WorkPath, logFileName)
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
 
 	if err := writeFileRelocatorLogFile(log, logPath); err != nil {
 		fmt.Printf("Warning: Failed to write log file: %v\n", err)
@@ -95,7 +103,7 @@ func (m *FileRelocator) Process(cfg *config.Config) error {
 	return nil
 }
 
-func processMoveFiles(workDir string, pluginConfig FileRelocatorConfig, log *FileRelocatorLog) (int, error) {
+func processMoveFiles(workDir string, pluginConfig FileRelocatorConfig, filter *fileMatcher, log *FileRelocatorLog) (int, error) {
 	finalDir := filepath.Join(workDir, pluginConfig.SourceLocation)
 	processFileCount := 0 // 총 파일 개수
 
@@ -106,7 +114,7 @@ func processMoveFiles(workDir string, pluginConfig FileRelocatorConfig, log *Fil
 				return err
 			}
 
-			if err := processFile(path, d.Name(), workDir, pluginConfig, log); err != nil {
+			if err := processFile(path, d, workDir, pluginConfig, filter, log); err != nil {
 				return err
 			}
 			processFileCount++
@@ -129,7 +137,7 @@ func processMoveFiles(workDir string, pluginConfig FileRelocatorConfig, log *Fil
 			}
 
 			sourcePath := filepath.Join(finalDir, entry.Name())
-			if err := processFile(sourcePath, entry.Name(), workDir, pluginConfig, log); err != nil {
+			if err := processFile(sourcePath, entry, workDir, pluginConfig, filter, log); err != nil {
 				return processFileCount, err
 			}
 			processFileCount++
@@ -137,42 +145,81 @@ func processMoveFiles(workDir string, pluginConfig FileRelocatorConfig, log *Fil
 		}
 	}
 
+	if pluginConfig.RemoveEmptyDirs {
+		removed, err := utils.RemoveEmptyDirs(finalDir, pluginConfig.ExcludeDirs)
+		log.RemovedDirs = append(log.RemovedDirs, removed...)
+		if err != nil {
+			return processFileCount, err
+		}
+
+		// 모두 옮겨서 source_location 자체가 비었으면 함께 삭제 (depth 폴더는 유지)
+		if filepath.Clean(finalDir) != filepath.Clean(workDir) {
+			removedSelf, err := utils.RemoveDirIfEmpty(workDir, finalDir, pluginConfig.ExcludeDirs)
+			if err != nil {
+				return processFileCount, err
+			}
+			if removedSelf {
+				log.RemovedDirs = append(log.RemovedDirs, finalDir)
+			}
+		}
+	}
+
 	return 0, nil
 }
 
 // processFile: 단일 파일을 target_location으로 이동
-func processFile(sourcePath string, fileName string, baseDir string,
-	pluginConfig FileRelocatorConfig, log *FileRelocatorLog) error {
-	// 확장자 체크
-	ext := strings.TrimPrefix(filepath.Ext(fileName), ".")
-
-	if len(pluginConfig.FileExtensions) > 0 &&
-		!slices.Contains(pluginConfig.FileExtensions, ext) {
+func processFile(sourcePath string, d fs.DirEntry, baseDir string,
+	pluginConfig FileRelocatorConfig, filter *fileMatcher, log *FileRelocatorLog) error {
+	// 파일 선택 조건 체크 (확장자는 대소문자 구분 없음)
+	if !filter.match(sourcePath, d) {
 		return nil // 건너뛰기
 	}
 
 	// target_location 경로
 	targetDirPath := filepath.Join(baseDir, pluginConfig.TargetLocation)
 
-	// target_location 디렉터리 확인
-	err := ensureDir(targetDirPath, pluginConfig.CreateFolder)
+	targetPath, moved, err := moveFile(sourcePath, targetDirPath, pluginConfig.CreateFolder, pluginConfig.OverwriteFiles)
 	if err != nil {
-		return err
+		if !moved {
+			return err // target_location 없음
+		}
+		log.FailedMoves = append(log.FailedMoves, sourcePath)
+		return nil
+	}
+
+	if moved {
+		log.MovedFiles[sourcePath] = targetPath
+	}
+	return nil
+}
+
+// moveFile: 파일을 targetDirPath 폴더로 같은 이름으로 이동한다.
+// 대상 파일이 이미 있고 overwrite가 false면 이동하지 않는다.
+// return: 대상 경로, 이동 시도 여부(moved), 에러 - 이동 중 에러면 moved가 true
+func moveFile(sourcePath string, targetDirPath string, createFolder bool, overwrite bool) (string, bool, error) {
+	// 대상 디렉터리 확인
+	err := ensureDir(targetDirPath, createFolder)
+	if err != nil {
+		return "", false, err
 	}
 
 	// 최종 경로
-	targetPath := filepath.Join(targetDirPath, fileName)
+	targetPath := filepath.Join(targetDirPath, filepath.Base(sourcePath))
+	if targetPath == sourcePath {
+		return targetPath, false, nil // 이미 대상 위치
+	}
 
 	// 덮어쓰기 체크
 	if _, err := os.Stat(targetPath); err == nil {
-		if !pluginConfig.OverwriteFiles {
-			return nil // 건너뛰기
+		if !overwrite {
+			return targetPath, false, nil // 건너뛰기
 		}
 	}
 
-	os.Rename(sourcePath, targetPath)
-	log.MovedFiles[sourcePath] = targetPath
-	return nil
+	if err := os.Rename(sourcePath, targetPath); err != nil {
+		return targetPath, true, err
+	}
+	return targetPath, true, nil
 }
 
 // 디렉터리 존재 확인 + 필요시 생성
@@ -201,6 +248,18 @@ func writeFileRelocatorLogFile(log *FileRelocatorLog, logPath string) error {
 		fmt.Fprintf(file, "MOVED: %s -> %s\n", original, moved)
 	}
 
+	fmt.Fprintf(file, "\n=== FAILED MOVES ===\n")
+	for _, failed := range log.FailedMoves {
+		fmt.Fprintf(file, "FAILED: %s\n", failed)
+	}
+
+	if len(log.RemovedDirs) > 0 {
+		fmt.Fprintf(file, "\n=== REMOVED EMPTY DIRS ===\n")
+		for _, removed := range log.RemovedDirs {
+			fmt.Fprintf(file, "REMOVED: %s\n", removed)
+		}
+	}
+
 	return nil
 }
 
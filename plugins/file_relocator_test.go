@@ -0,0 +1,49 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestProcessMoveFilesRemovesEmptySource(t *testing.T) {
+	tests := []struct {
+		name    string
+		exclude []string
+		removed bool
+	}{
+		{"empty source removed", nil, true},
+		{"excluded source kept", []string{"inbox"}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			os.MkdirAll(filepath.Join(workDir, "inbox", "sub"), 0755)
+			os.WriteFile(filepath.Join(workDir, "inbox", "sub", "a.pdf"), nil, 0644)
+
+			pluginConfig := FileRelocatorConfig{
+				SourceLocation:  "inbox",
+				TargetLocation:  "done",
+				CreateFolder:    true,
+				SearchSubdirs:   true,
+				RemoveEmptyDirs: true,
+				ExcludeDirs:     tc.exclude,
+			}
+			log := &FileRelocatorLog{MovedFiles: make(map[string]string)}
+			filter, _ := pluginConfig.FileFilter.compile(time.Now())
+
+			if _, err := processMoveFiles(workDir, pluginConfig, filter, log); err != nil {
+				t.Fatalf("processMoveFiles failed: %v", err)
+			}
+			if _, err := os.Stat(filepath.Join(workDir, "done", "a.pdf")); err != nil {
+				t.Errorf("expected file moved: %v", err)
+			}
+			_, err := os.Stat(filepath.Join(workDir, "inbox"))
+			if removed := os.IsNotExist(err); removed != tc.removed {
+				t.Errorf("expected inbox removed=%v, got %v (%v)", tc.removed, removed, log.RemovedDirs)
+			}
+		})
+	}
+}
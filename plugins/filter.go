@@ -0,0 +1,149 @@
+package plugins
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/utils"
+)
+
+// hidden 옵션 값
+const (
+	HiddenInclude = "include" // 숨김 파일도 포함 (기본값)
+	HiddenExclude = "exclude" // 숨김 파일 제외
+	HiddenOnly    = "only"    // 숨김 파일만
+)
+
+// FileFilter 파일 선택 조건 - 파일을 다루는 플러그인 설정에 포함된다.
+// 지정한 조건을 모두 만족하는 파일만 선택하며, 비어 있는 조건은 검사하지 않는다.
+type FileFilter struct {
+	FileExtensions []string `json:"file_extensions,omitempty"` // 확장자 (대소문자, 점 무시)
+	NamePattern    string   `json:"name_pattern,omitempty"`    // 파일명 정규식
+	NameGlob       string   `json:"name_glob,omitempty"`       // 파일명 glob (예: report_*.pdf)
+	MinSize        string   `json:"min_size,omitempty"`        // 최소 크기 (예: 10MB)
+	MaxSize        string   `json:"max_size,omitempty"`        // 최대 크기
+	OlderThanDays  int      `json:"older_than_days,omitempty"` // 수정 시간이 지정한 일 수보다 오래된 파일만
+	NewerThanDays  int      `json:"newer_than_days,omitempty"` // 수정 시간이 지정한 일 수 이내인 파일만
+	Hidden         string   `json:"hidden,omitempty"`          // include, exclude, only
+}
+
+// fileMatcher 검사 준비가 끝난 FileFilter
+type fileMatcher struct {
+	filter    FileFilter
+	nameRegex *regexp.Regexp
+	minSize   int64 // -1이면 검사 안 함
+	maxSize   int64 // -1이면 검사 안 함
+	now       time.Time
+}
+
+// compile: 정규식과 크기를 미리 해석한다. 나이는 now 기준으로 계산한다.
+func (f *FileFilter) compile(now time.Time) (*fileMatcher, error) {
+	m := &fileMatcher{filter: *f, minSize: -1, maxSize: -1, now: now}
+
+	if f.NamePattern != "" {
+		re, err := regexp.Compile(f.NamePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_pattern: %v", err)
+		}
+		m.nameRegex = re
+	}
+	if f.NameGlob != "" {
+		if _, err := filepath.Match(f.NameGlob, ""); err != nil {
+			return nil, fmt.Errorf("invalid name_glob: %v", err)
+		}
+	}
+
+	if f.MinSize != "" {
+		size, err := utils.ParseSize(f.MinSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid min_size: %v", err)
+		}
+		m.minSize = size
+	}
+	if f.MaxSize != "" {
+		size, err := utils.ParseSize(f.MaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max_size: %v", err)
+		}
+		m.maxSize = size
+	}
+	if m.minSize >= 0 && m.maxSize >= 0 && m.minSize > m.maxSize {
+		return nil, fmt.Errorf("min_size (%s) is greater than max_size (%s)", f.MinSize, f.MaxSize)
+	}
+	if f.OlderThanDays > 0 && f.NewerThanDays > 0 && f.OlderThanDays > f.NewerThanDays {
+		return nil, fmt.Errorf("older_than_days (%d) is greater than newer_than_days (%d)", f.OlderThanDays, f.NewerThanDays)
+	}
+
+	switch f.Hidden {
+	case "", HiddenInclude, HiddenExclude, HiddenOnly:
+	default:
+		return nil, fmt.Errorf("unknown hidden option: %s", f.Hidden)
+	}
+
+	return m, nil
+}
+
+// match: 파일이 조건을 만족하는지 확인한다. d가 nil이면 path로 파일 정보를 읽으며,
+// 크기나 나이 조건이 있는데 파일 정보를 읽을 수 없으면 선택하지 않는다.
+func (m *fileMatcher) match(path string, d fs.DirEntry) bool {
+	name := filepath.Base(path)
+	f := m.filter
+
+	hidden := strings.HasPrefix(name, ".")
+	switch f.Hidden {
+	case HiddenExclude:
+		if hidden {
+			return false
+		}
+	case HiddenOnly:
+		if !hidden {
+			return false
+		}
+	}
+
+	if !utils.HasExtension(name, f.FileExtensions) {
+		return false
+	}
+	if m.nameRegex != nil && !m.nameRegex.MatchString(utils.NormalizeName(name)) {
+		return false
+	}
+	if f.NameGlob != "" {
+		if ok, _ := filepath.Match(utils.NormalizeName(f.NameGlob), utils.NormalizeName(name)); !ok {
+			return false
+		}
+	}
+
+	if m.minSize < 0 && m.maxSize < 0 && f.OlderThanDays <= 0 && f.NewerThanDays <= 0 {
+		return true
+	}
+
+	var info fs.FileInfo
+	var err error
+	if d != nil {
+		info, err = d.Info()
+	} else {
+		info, err = os.Stat(path)
+	}
+	if err != nil {
+		return false
+	}
+
+	if m.minSize >= 0 && info.Size() < m.minSize {
+		return false
+	}
+	if m.maxSize >= 0 && info.Size() > m.maxSize {
+		return false
+	}
+	if f.OlderThanDays > 0 && !info.ModTime().Before(m.now.AddDate(0, 0, -f.OlderThanDays)) {
+		return false
+	}
+	if f.NewerThanDays > 0 && info.ModTime().Before(m.now.AddDate(0, 0, -f.NewerThanDays)) {
+		return false
+	}
+	return true
+}
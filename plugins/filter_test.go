@@ -0,0 +1,82 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileFilter(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+
+	// 이름 -> 크기, 며칠 전 수정
+	files := map[string]struct{ size, days int }{
+		"report.PDF":   {2048, 1},
+		"notes.txt":    {10, 40},
+		"big.iso":      {5000, 100},
+		".hidden.txt":  {10, 1},
+		"report_2.pdf": {100, 10},
+	}
+	for name, file := range files {
+		path := filepath.Join(dir, name)
+		os.WriteFile(path, make([]byte, file.size), 0644)
+		modTime := now.AddDate(0, 0, -file.days)
+		os.Chtimes(path, modTime, modTime)
+	}
+
+	tests := []struct {
+		name     string
+		filter   FileFilter
+		expected []string
+	}{
+		{"empty", FileFilter{}, []string{".hidden.txt", "big.iso", "notes.txt", "report.PDF", "report_2.pdf"}},
+		{"extensions", FileFilter{FileExtensions: []string{"pdf"}}, []string{"report.PDF", "report_2.pdf"}},
+		{"name pattern", FileFilter{NamePattern: `_\d+\.`}, []string{"report_2.pdf"}},
+		{"name glob", FileFilter{NameGlob: "report*"}, []string{"report.PDF", "report_2.pdf"}},
+		{"min size", FileFilter{MinSize: "2KB"}, []string{"big.iso", "report.PDF"}},
+		{"size range", FileFilter{MinSize: "50", MaxSize: "2KB"}, []string{"report.PDF", "report_2.pdf"}},
+		{"older than", FileFilter{OlderThanDays: 30}, []string{"big.iso", "notes.txt"}},
+		{"newer than", FileFilter{NewerThanDays: 5}, []string{".hidden.txt", "report.PDF"}},
+		{"hidden exclude", FileFilter{FileExtensions: []string{"txt"}, Hidden: HiddenExclude}, []string{"notes.txt"}},
+		{"hidden only", FileFilter{Hidden: HiddenOnly}, []string{".hidden.txt"}},
+	}
+
+	entries, _ := os.ReadDir(dir)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := tc.filter.compile(now)
+			if err != nil {
+				t.Fatalf("compile failed: %v", err)
+			}
+
+			var matched []string
+			for _, entry := range entries {
+				if m.match(filepath.Join(dir, entry.Name()), entry) {
+					matched = append(matched, entry.Name())
+				}
+			}
+			if len(matched) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, matched)
+			}
+			for i := range matched {
+				if matched[i] != tc.expected[i] {
+					t.Errorf("expected %v, got %v", tc.expected, matched)
+				}
+			}
+		})
+	}
+
+	for _, filter := range []FileFilter{
+		{NamePattern: "("},
+		{NameGlob: "["},
+		{MinSize: "10XB"},
+		{MinSize: "2MB", MaxSize: "1MB"},
+		{Hidden: "maybe"},
+	} {
+		if _, err := filter.compile(now); err == nil {
+			t.Errorf("%+v: expected error", filter)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Flatten struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 같은 이름의 파일이 있을 때 새 이름을 만드는 방식
+const (
+	FlattenNamingSuffix = "suffix" // report_1.pdf (기본값)
+	FlattenNamingPath   = "path"   // sub_dir_report.pdf (상대 경로를 이름에 포함)
+)
+
+// Flatten 플러그인의 설정값 구조체
+type FlattenConfig struct {
+	Naming          string `json:"naming,omitempty"`    // suffix, path
+	Separator       string `json:"separator,omitempty"` // naming: path일 때 폴더 구분 문자 (기본 "_")
+	RemoveEmptyDirs bool   `json:"remove_empty_dirs"`   // 이동 후 빈 하위 폴더 삭제
+
+	// 이동할 파일 선택 조건
+	FileFilter
+
+	// 평탄화할 타겟 폴더와 깊이 - depth 폴더 아래의 모든 파일을 depth 폴더로 이동
+	TargetScope
+}
+
+type FlattenLog struct {
+	MovedFiles  map[string]string // 원본경로 -> 대상경로
+	Collisions  []string          // 이동하지 않은 항목
+	RemovedDirs []string          // 삭제한 빈 폴더
+	TotalFiles  int
+}
+
+func (f *Flatten) Process(cfg *config.Config) error {
+	totalProcessed := 0
+	log := &FlattenLog{
+		MovedFiles: make(map[string]string),
+	}
+
+	// 설정 구조체
+	var pluginConfig FlattenConfig
+
+	// Config 파싱
+	if f.pluginCfg != nil && len(f.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(f.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	switch pluginConfig.Naming {
+	case "":
+		pluginConfig.Naming = FlattenNamingSuffix
+	case FlattenNamingSuffix, FlattenNamingPath:
+	default:
+		return fmt.Errorf("unknown flatten naming: %s", pluginConfig.Naming)
+	}
+	if pluginConfig.Separator == "" {
+		pluginConfig.Separator = "_"
+	}
+	if strings.ContainsAny(pluginConfig.Separator, `/\`) {
+		return fmt.Errorf("separator must not contain path separators: %q", pluginConfig.Separator)
+	}
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range workDirs {
+		// 앞의 작업 경로를 평탄화하면서 삭제된 폴더
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+
+		count, err := flattenDir(dir, pluginConfig, filter, log)
+		totalProcessed += count
+		if err != nil {
+			return err
+		}
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("flatten_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeFlattenLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// flattenDir: workDir 하위 폴더의 파일을 모두 workDir로 옮긴다.
+// 이름이 겹치면 naming 방식으로 새 이름을 만든다.
+func flattenDir(workDir string, pluginConfig FlattenConfig, filter *fileMatcher, log *FlattenLog) (int, error) {
+	// workDir에 이미 있는 이름 + 이번에 정한 이름
+	used := make(map[string]bool)
+	entries, err := os.ReadDir(workDir)
+	if err != nil {
+		return 0, err
+	}
+	for _, entry := range entries {
+		used[utils.NormalizeName(entry.Name())] = true
+	}
+
+	var pairs []utils.RenamePair
+	err = filepath.WalkDir(workDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Dir(path) == workDir {
+			return err
+		}
+		if !filter.match(path, d) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(workDir, path)
+		if err != nil {
+			return err
+		}
+
+		name := flattenName(rel, pluginConfig, used)
+		used[utils.NormalizeName(name)] = true
+		pairs = append(pairs, utils.RenamePair{From: path, To: filepath.Join(workDir, name)})
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	valid, conflicts := utils.PlanRenames(pairs)
+	log.Collisions = append(log.Collisions, conflicts...)
+
+	done, err := utils.ApplyRenames(valid)
+	for _, rename := range done {
+		log.MovedFiles[rename.From] = rename.To
+	}
+	if err != nil {
+		return len(done), err
+	}
+
+	if pluginConfig.RemoveEmptyDirs {
+		removed, err := utils.RemoveEmptyDirs(workDir, nil)
+		log.RemovedDirs = append(log.RemovedDirs, removed...)
+		if err != nil {
+			return len(valid), err
+		}
+	}
+
+	return len(valid), nil
+}
+
+// flattenName: rel(workDir 기준 상대 경로)의 파일을 workDir로 옮길 때 사용할 이름
+func flattenName(rel string, pluginConfig FlattenConfig, used map[string]bool) string {
+	name := filepath.Base(rel)
+	if pluginConfig.Naming == FlattenNamingPath {
+		name = strings.Join(strings.Split(filepath.ToSlash(rel), "/"), pluginConfig.Separator)
+	}
+
+	if !used[utils.NormalizeName(name)] {
+		return name
+	}
+
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s_%d%s", stem, i, ext)
+		if !used[utils.NormalizeName(candidate)] {
+			return candidate
+		}
+	}
+}
+
+func writeFlattenLogFile(log *FlattenLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Flatten Processing Log\n")
+	fmt.Fprintf(file, "Total files processed: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== MOVED FILES ===\n")
+	for original, moved := range log.MovedFiles {
+		fmt.Fprintf(file, "MOVED: %s -> %s\n", original, moved)
+	}
+
+	fmt.Fprintf(file, "\n=== COLLISIONS ===\n")
+	for _, collision := range log.Collisions {
+		fmt.Fprintf(file, "COLLISION: %s\n", collision)
+	}
+
+	fmt.Fprintf(file, "\n=== REMOVED EMPTY DIRS ===\n")
+	for _, removed := range log.RemovedDirs {
+		fmt.Fprintf(file, "REMOVED: %s\n", removed)
+	}
+
+	return nil
+}
+
+func (f *Flatten) GetName() string {
+	return "FLATTEN"
+}
+
+func (f *Flatten) GetDescription() string {
+	return "depth 폴더 아래 하위 폴더의 파일을 모두 depth 폴더로 이동합니다. " +
+		"같은 이름이 있으면 번호 또는 상대 경로를 붙여 새 이름을 만듭니다."
+}
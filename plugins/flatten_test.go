@@ -0,0 +1,47 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFlattenDir(t *testing.T) {
+	for _, tc := range []struct {
+		naming   string
+		expected []string
+	}{
+		{FlattenNamingSuffix, []string{"report.pdf", "report_1.pdf", "report_2.pdf"}},
+		{FlattenNamingPath, []string{"a_b_report.pdf", "a_report.pdf", "report.pdf"}},
+	} {
+		t.Run(tc.naming, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, path := range []string{"report.pdf", "a/report.pdf", "a/b/report.pdf"} {
+				os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0755)
+				os.WriteFile(filepath.Join(dir, filepath.FromSlash(path)), nil, 0644)
+			}
+
+			pluginConfig := FlattenConfig{Naming: tc.naming, Separator: "_", RemoveEmptyDirs: true}
+			log := &FlattenLog{MovedFiles: make(map[string]string)}
+			filter, _ := pluginConfig.FileFilter.compile(time.Now())
+			if _, err := flattenDir(dir, pluginConfig, filter, log); err != nil {
+				t.Fatalf("flattenDir failed: %v", err)
+			}
+
+			entries, _ := os.ReadDir(dir)
+			var names []string
+			for _, entry := range entries {
+				names = append(names, entry.Name())
+			}
+			if len(names) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, names)
+			}
+			for i := range names {
+				if names[i] != tc.expected[i] {
+					t.Errorf("expected %v, got %v", tc.expected, names)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package plugins
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Index struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 목록 파일 형식
+const (
+	IndexCSV    = "csv" // 기본값
+	IndexJSON   = "json"
+	IndexSQLite = "sqlite"
+)
+
+// Index 플러그인의 설정값 구조체
+type IndexConfig struct {
+	Format   string `json:"format,omitempty"`    // csv, json, sqlite
+	Output   string `json:"output,omitempty"`    // 목록 파일 경로 (work_path 기준, 기본 file_index.<format>)
+	SkipHash bool   `json:"skip_hash,omitempty"` // SHA-256 해시 계산 생략
+
+	// 목록에 넣을 파일 선택 조건
+	FileFilter
+
+	// 목록을 만들 타겟 폴더 - 타겟 폴더 아래의 모든 파일
+	TargetScope
+}
+
+// IndexEntry: 목록의 파일 한 건
+type IndexEntry struct {
+	Path         string    `json:"path"`          // work_path 기준 상대 경로
+	TargetFolder string    `json:"target_folder"` // work_path 기준 타겟 폴더
+	Segments     []string  `json:"segments"`      // 타겟 폴더와 파일 사이의 폴더 이름
+	Depth        int       `json:"depth"`         // 파일이 있는 폴더의 깊이 (타겟 폴더 = 1)
+	Name         string    `json:"name"`
+	Ext          string    `json:"ext"` // 소문자, 점 제외
+	Size         int64     `json:"size"`
+	ModTime      time.Time `json:"mtime"`
+	Hash         string    `json:"hash,omitempty"` // SHA-256 (hex)
+}
+
+type IndexLog struct {
+	OutputPath string
+	Format     string
+	TotalBytes int64
+	TotalFiles int
+}
+
+func (i *Index) Process(cfg *config.Config) error {
+	// 설정 구조체
+	var pluginConfig IndexConfig
+
+	// Config 파싱
+	if i.pluginCfg != nil && len(i.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(i.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	switch pluginConfig.Format {
+	case "":
+		pluginConfig.Format = IndexCSV
+	case IndexCSV, IndexJSON, IndexSQLite:
+	default:
+		return fmt.Errorf("unknown index format: %s", pluginConfig.Format)
+	}
+	if pluginConfig.Output == "" {
+		pluginConfig.Output = "file_index." + pluginConfig.Format
+	}
+	outputPath := filepath.Join(cfg.WorkPath, pluginConfig.Output)
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	basePaths, err := pluginConfig.targetFolderPaths(cfg)
+	if err != nil {
+		return err
+	}
+
+	entries, err := collectIndexEntries(cfg.WorkPath, basePaths, outputPath, filter, !pluginConfig.SkipHash)
+	if err != nil {
+		return err
+	}
+
+	if err := writeIndex(outputPath, pluginConfig.Format, entries); err != nil {
+		return fmt.Errorf("failed to write index: %v", err)
+	}
+
+	log := &IndexLog{OutputPath: outputPath, Format: pluginConfig.Format, TotalFiles: len(entries)}
+	for _, entry := range entries {
+		log.TotalBytes += entry.Size
+	}
+
+	logFileName := fmt.Sprintf("index_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeIndexLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// collectIndexEntries: 타겟 폴더 아래에서 조건에 맞는 파일 정보를 모은다. outputPath(이전 목록 파일)는 제외한다.
+func collectIndexEntries(workPath string, basePaths []string, outputPath string, filter *fileMatcher, withHash bool) ([]IndexEntry, error) {
+	var entries []IndexEntry
+	seen := make(map[string]bool)
+
+	for _, basePath := range basePaths {
+		targetFolder, err := filepath.Rel(workPath, basePath)
+		if err != nil {
+			return nil, err
+		}
+
+		err = filepath.WalkDir(basePath, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || seen[path] || path == outputPath {
+				return err
+			}
+			if !filter.match(path, d) {
+				return nil
+			}
+			seen[path] = true
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			rel, _ := filepath.Rel(workPath, path)
+			dirRel, _ := filepath.Rel(basePath, filepath.Dir(path))
+			var segments []string
+			if dirRel != "." {
+				segments = strings.Split(filepath.ToSlash(dirRel), "/")
+			}
+
+			entry := IndexEntry{
+				Path:         filepath.ToSlash(rel),
+				TargetFolder: filepath.ToSlash(targetFolder),
+				Segments:     segments,
+				Depth:        len(segments) + 1,
+				Name:         d.Name(),
+				Ext:          utils.NormalizeExt(filepath.Ext(d.Name())),
+				Size:         info.Size(),
+				ModTime:      info.ModTime(),
+			}
+			if withHash {
+				if entry.Hash, err = utils.HashFile(path, 0); err != nil {
+					return err
+				}
+			}
+
+			entries = append(entries, entry)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+// writeIndex: 임시 파일에 쓴 뒤 outputPath로 교체한다.
+func writeIndex(outputPath, format string, entries []IndexEntry) error {
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return err
+	}
+
+	tempPath := outputPath + ".fm-index"
+	os.Remove(tempPath)
+
+	var err error
+	switch format {
+	case IndexCSV:
+		err = writeIndexCSV(tempPath, entries)
+	case IndexJSON:
+		err = writeIndexJSON(tempPath, entries)
+	case IndexSQLite:
+		err = writeIndexSQLite(tempPath, entries)
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	return os.Rename(tempPath, outputPath)
+}
+
+func writeIndexCSV(path string, entries []IndexEntry) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	w.Write([]string{"path", "target_folder", "segments", "depth", "name", "ext", "size", "mtime", "hash"})
+	for _, entry := range entries {
+		w.Write([]string{
+			entry.Path,
+			entry.TargetFolder,
+			strings.Join(entry.Segments, "/"),
+			strconv.Itoa(entry.Depth),
+			entry.Name,
+			entry.Ext,
+			strconv.FormatInt(entry.Size, 10),
+			entry.ModTime.Format(time.RFC3339),
+			entry.Hash,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+func writeIndexJSON(path string, entries []IndexEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func writeIndexSQLite(path string, entries []IndexEntry) error {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	schema := `
+CREATE TABLE files (
+	path          TEXT PRIMARY KEY,
+	target_folder TEXT NOT NULL,
+	segments      TEXT NOT NULL,
+	depth         INTEGER NOT NULL,
+	name          TEXT NOT NULL,
+	ext           TEXT NOT NULL,
+	size          INTEGER NOT NULL,
+	mtime         TEXT NOT NULL,
+	hash          TEXT
+);
+CREATE INDEX files_name ON files(name);
+CREATE INDEX files_ext ON files(ext);
+CREATE INDEX files_hash ON files(hash);`
+	if _, err := db.Exec(schema); err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare(`INSERT INTO files VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	for _, entry := range entries {
+		var hash any
+		if entry.Hash != "" {
+			hash = entry.Hash
+		}
+		_, err := stmt.Exec(entry.Path, entry.TargetFolder, strings.Join(entry.Segments, "/"), entry.Depth,
+			entry.Name, entry.Ext, entry.Size, entry.ModTime.Format(time.RFC3339), hash)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func writeIndexLogFile(log *IndexLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Index Processing Log\n")
+	fmt.Fprintf(file, "Format: %s\n", log.Format)
+	fmt.Fprintf(file, "Output: %s\n", log.OutputPath)
+	fmt.Fprintf(file, "Total files indexed: %d\n", log.TotalFiles)
+	fmt.Fprintf(file, "Total bytes: %d\n", log.TotalBytes)
+
+	return nil
+}
+
+func (i *Index) GetName() string {
+	return "INDEX"
+}
+
+func (i *Index) GetDescription() string {
+	return "타겟 폴더 아래 모든 파일의 목록(경로, 크기, 수정 시간, 확장자, 해시)을 " +
+		"work_path에 CSV, JSON 또는 SQLite로 저장합니다."
+}
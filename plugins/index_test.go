@@ -0,0 +1,63 @@
+package plugins
+
+import (
+	"database/sql"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestIndex(t *testing.T) {
+	work := t.TempDir()
+	for _, path := range []string{"paper/1111/a.PDF", "paper/1111/2222/b.txt", "homework/c.txt"} {
+		os.MkdirAll(filepath.Join(work, filepath.Dir(path)), 0755)
+		os.WriteFile(filepath.Join(work, filepath.FromSlash(path)), []byte(path), 0644)
+	}
+
+	basePaths := []string{filepath.Join(work, "paper"), filepath.Join(work, "homework")}
+	filter, _ := (&FileFilter{}).compile(time.Now())
+	entries, err := collectIndexEntries(work, basePaths, "", filter, true)
+	if err != nil {
+		t.Fatalf("collectIndexEntries failed: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+
+	byPath := make(map[string]IndexEntry)
+	for _, entry := range entries {
+		byPath[entry.Path] = entry
+	}
+	b := byPath["paper/1111/2222/b.txt"]
+	if b.TargetFolder != "paper" || b.Depth != 3 || len(b.Segments) != 2 || b.Segments[1] != "2222" || b.Hash == "" {
+		t.Errorf("unexpected entry: %+v", b)
+	}
+	if a := byPath["paper/1111/a.PDF"]; a.Ext != "pdf" {
+		t.Errorf("expected normalized ext, got %q", a.Ext)
+	}
+
+	for _, format := range []string{IndexCSV, IndexJSON, IndexSQLite} {
+		outputPath := filepath.Join(work, "file_index."+format)
+		if err := writeIndex(outputPath, format, entries); err != nil {
+			t.Fatalf("%s: writeIndex failed: %v", format, err)
+		}
+	}
+
+	data, _ := os.ReadFile(filepath.Join(work, "file_index.json"))
+	var decoded []IndexEntry
+	if err := json.Unmarshal(data, &decoded); err != nil || len(decoded) != 3 {
+		t.Errorf("json index: %v, %d entries", err, len(decoded))
+	}
+
+	db, err := sql.Open("sqlite", filepath.Join(work, "file_index.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM files WHERE target_folder = 'paper'`).Scan(&count); err != nil || count != 2 {
+		t.Errorf("sqlite index: %v, %d rows", err, count)
+	}
+}
@@ -6,4 +6,4 @@ type Plugin interface {
 	Process(cfg *config.Config) error
 	GetName() string
 	GetDescription() string
-}
\ No newline at end of file
+}
@@ -0,0 +1,251 @@
+package plugins
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type LargeFiles struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 큰 파일 처리 방식
+const (
+	LargeFilesReport = "report" // 로그에만 기록 (기본값)
+	LargeFilesMove   = "move"   // target_location 폴더로 이동
+)
+
+// LargeFiles 플러그인의 설정값 구조체
+type LargeFilesConfig struct {
+	Threshold string `json:"threshold"`        // 이 크기 이상인 파일 (예: 500MB)
+	Action    string `json:"action,omitempty"` // report, move
+
+	// action: move일 때 사용
+	TargetLocation string `json:"target_location,omitempty"` // 이동할 폴더 (depth 폴더 기준)
+	CreateFolder   bool   `json:"create_folder"`             // 이동할 폴더가 없을 때 자동 생성 여부
+	OverwriteFiles bool   `json:"overwrite_files"`           // 이동할 위치에 이미 파일이 있다면 덮어쓰기 여부
+
+	SearchSubdirs bool `json:"search_subdirs"` // 하위 폴더까지 검색 여부
+
+	// 대상 파일 선택 조건
+	FileFilter
+
+	// 검사할 타겟 폴더와 깊이
+	TargetScope
+}
+
+type LargeFilesLog struct {
+	Files        []LargeFileLog    // 크기가 큰 순서
+	MovedFiles   map[string]string // 원본경로 -> 대상경로
+	SkippedFiles []string          // 대상 위치에 같은 이름의 파일이 있어 건너뜀
+	FailedMoves  []string          // 실패한 파일 (전체 경로)
+	Threshold    int64
+	TotalBytes   int64
+	Action       string
+	TotalFiles   int
+}
+
+type LargeFileLog struct {
+	Path    string
+	Size    int64
+	workDir string // 파일을 찾은 작업 경로 (target_location 기준)
+}
+
+func (l *LargeFiles) Process(cfg *config.Config) error {
+	// 설정 구조체
+	var pluginConfig LargeFilesConfig
+
+	// Config 파싱
+	if l.pluginCfg != nil && len(l.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(l.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	if pluginConfig.Threshold == "" {
+		return fmt.Errorf("threshold is required")
+	}
+	threshold, err := utils.ParseSize(pluginConfig.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold: %v", err)
+	}
+
+	switch pluginConfig.Action {
+	case "":
+		pluginConfig.Action = LargeFilesReport
+	case LargeFilesReport:
+	case LargeFilesMove:
+		if pluginConfig.TargetLocation == "" {
+			return fmt.Errorf("target_location is required for action: move")
+		}
+	default:
+		return fmt.Errorf("unknown large_files action: %s", pluginConfig.Action)
+	}
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	files, err := findLargeFiles(workDirs, pluginConfig.SearchSubdirs, filter, threshold)
+	if err != nil {
+		return err
+	}
+
+	log := &LargeFilesLog{
+		MovedFiles: make(map[string]string),
+		Threshold:  threshold,
+		Action:     pluginConfig.Action,
+	}
+	count, err := applyLargeFiles(files, pluginConfig, log)
+	log.TotalFiles = count
+	if err != nil {
+		return err
+	}
+
+	logFileName := fmt.Sprintf("large_files_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeLargeFilesLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// findLargeFiles: 작업 경로에서 threshold 이상이고 선택 조건에 맞는 파일을 크기가 큰 순서로 찾는다.
+// depth 범위가 겹쳐도 같은 파일은 한 번만 포함한다.
+func findLargeFiles(workDirs []string, searchSubdirs bool, filter *fileMatcher, threshold int64) ([]LargeFileLog, error) {
+	var files []LargeFileLog
+	seen := make(map[string]bool)
+	for _, dir := range workDirs {
+		err := walkFiles(dir, searchSubdirs, func(path string) error {
+			if seen[path] || !filter.match(path, nil) {
+				return nil
+			}
+			seen[path] = true
+
+			info, err := os.Stat(path)
+			if err != nil {
+				return err
+			}
+			if info.Size() >= threshold {
+				files = append(files, LargeFileLog{Path: path, Size: info.Size(), workDir: dir})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 큰 파일이 앞에 오도록 정렬 (크기가 같으면 경로 순)
+	slices.SortFunc(files, func(a, b LargeFileLog) int {
+		if c := cmp.Compare(b.Size, a.Size); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Path, b.Path)
+	})
+	return files, nil
+}
+
+// applyLargeFiles: 찾은 파일을 기록하고 action: move면 target_location으로 이동한다.
+func applyLargeFiles(files []LargeFileLog, pluginConfig LargeFilesConfig, log *LargeFilesLog) (int, error) {
+	processFileCount := 0
+
+	for _, file := range files {
+		log.Files = append(log.Files, file)
+		log.TotalBytes += file.Size
+
+		if pluginConfig.Action != LargeFilesMove {
+			processFileCount++
+			continue
+		}
+
+		targetDirPath := filepath.Join(file.workDir, pluginConfig.TargetLocation)
+		targetPath, moved, err := moveFile(file.Path, targetDirPath, pluginConfig.CreateFolder, pluginConfig.OverwriteFiles)
+		if err != nil {
+			if !moved {
+				return processFileCount, err // target_location 없음
+			}
+			log.FailedMoves = append(log.FailedMoves, file.Path)
+			continue
+		}
+		if !moved {
+			if targetPath != file.Path {
+				log.SkippedFiles = append(log.SkippedFiles, file.Path)
+			}
+			continue
+		}
+
+		log.MovedFiles[file.Path] = targetPath
+		processFileCount++
+	}
+
+	return processFileCount, nil
+}
+
+func writeLargeFilesLogFile(log *LargeFilesLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager LargeFiles Processing Log\n")
+	fmt.Fprintf(file, "Action: %s\n", log.Action)
+	fmt.Fprintf(file, "Threshold: %d bytes (%s)\n", log.Threshold, utils.FormatSize(log.Threshold))
+	fmt.Fprintf(file, "Total files processed: %d\n", log.TotalFiles)
+	fmt.Fprintf(file, "Total bytes: %d (%s)\n\n", log.TotalBytes, utils.FormatSize(log.TotalBytes))
+
+	fmt.Fprintf(file, "=== LARGE FILES ===\n")
+	for _, large := range log.Files {
+		fmt.Fprintf(file, "%10s  %s\n", utils.FormatSize(large.Size), large.Path)
+	}
+
+	if log.Action == LargeFilesMove {
+		fmt.Fprintf(file, "\n=== MOVED FILES ===\n")
+		for original, moved := range log.MovedFiles {
+			fmt.Fprintf(file, "MOVED: %s -> %s\n", original, moved)
+		}
+
+		fmt.Fprintf(file, "\n=== SKIPPED FILES ===\n")
+		for _, skipped := range log.SkippedFiles {
+			fmt.Fprintf(file, "SKIPPED: %s\n", skipped)
+		}
+
+		fmt.Fprintf(file, "\n=== FAILED MOVES ===\n")
+		for _, failed := range log.FailedMoves {
+			fmt.Fprintf(file, "FAILED: %s\n", failed)
+		}
+	}
+
+	return nil
+}
+
+func (l *LargeFiles) GetName() string {
+	return "LARGE_FILES"
+}
+
+func (l *LargeFiles) GetDescription() string {
+	return "지정한 크기 이상인 파일을 찾아 크기 순으로 보고하거나 지정된 폴더로 이동합니다."
+}
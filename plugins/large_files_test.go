@@ -0,0 +1,48 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLargeFiles(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "sub"), 0755)
+	for name, size := range map[string]int{"small.txt": 10, "video.mp4": 3000, "sub/disk.iso": 5000, "log.txt": 2000} {
+		os.WriteFile(filepath.Join(dir, filepath.FromSlash(name)), make([]byte, size), 0644)
+	}
+
+	filter, _ := (&FileFilter{FileExtensions: []string{"mp4", "iso", "png"}}).compile(time.Now())
+	files, err := findLargeFiles([]string{dir}, true, filter, 1000)
+	if err != nil {
+		t.Fatalf("findLargeFiles failed: %v", err)
+	}
+	// log.txt는 크지만 확장자 조건에 맞지 않음
+	if len(files) != 2 || filepath.Base(files[0].Path) != "disk.iso" || filepath.Base(files[1].Path) != "video.mp4" {
+		t.Fatalf("expected disk.iso, video.mp4 sorted by size, got %+v", files)
+	}
+
+	pluginConfig := LargeFilesConfig{Action: LargeFilesMove, TargetLocation: "large", CreateFolder: true}
+	log := &LargeFilesLog{MovedFiles: make(map[string]string)}
+	count, err := applyLargeFiles(files, pluginConfig, log)
+	if err != nil {
+		t.Fatalf("applyLargeFiles failed: %v", err)
+	}
+	if count != 2 || log.TotalBytes != 8000 {
+		t.Errorf("expected 2 files, 8000 bytes, got %d, %d", count, log.TotalBytes)
+	}
+	for _, name := range []string{"disk.iso", "video.mp4"} {
+		if _, err := os.Stat(filepath.Join(dir, "large", name)); err != nil {
+			t.Errorf("expected %s moved to large: %v", name, err)
+		}
+	}
+
+	// 이미 target_location에 있는 파일은 다시 옮기지 않음
+	files, _ = findLargeFiles([]string{dir}, true, filter, 1000)
+	log = &LargeFilesLog{MovedFiles: make(map[string]string)}
+	if count, _ := applyLargeFiles(files, pluginConfig, log); count != 0 || len(log.SkippedFiles) != 0 {
+		t.Errorf("expected no moves on second run, got %d moved, %v skipped", count, log.SkippedFiles)
+	}
+}
@@ -0,0 +1,139 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Manifest struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 매니페스트를 만드는 단위
+const (
+	ManifestPerTargetFolder = "target_folder" // 타겟 폴더마다 하나 (기본값)
+	ManifestPerDirectory    = "directory"     // depth 폴더마다 하나
+	ManifestPerLeaf         = "leaf"          // 하위 폴더가 없는 폴더마다 하나
+)
+
+// Manifest 플러그인의 설정값 구조체
+type ManifestConfig struct {
+	Scope string `json:"scope,omitempty"` // target_folder, directory, leaf
+	Name  string `json:"name,omitempty"`  // 매니페스트 파일명 (기본 SHA256SUMS)
+
+	// 매니페스트를 만들 타겟 폴더와 깊이
+	TargetScope
+}
+
+type ManifestLog struct {
+	Manifests  map[string]int // 매니페스트 경로 -> 기록한 파일 수
+	TotalFiles int
+}
+
+func (m *Manifest) Process(cfg *config.Config) error {
+	totalProcessed := 0
+	log := &ManifestLog{
+		Manifests: make(map[string]int),
+	}
+
+	// 설정 구조체
+	var pluginConfig ManifestConfig
+
+	// Config 파싱
+	if m.pluginCfg != nil && len(m.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(m.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	if pluginConfig.Name == "" {
+		pluginConfig.Name = utils.DefaultManifestName
+	}
+	if strings.ContainsAny(pluginConfig.Name, `/\`) {
+		return fmt.Errorf("manifest name must not contain path separators: %s", pluginConfig.Name)
+	}
+
+	var dirs []string
+	switch pluginConfig.Scope {
+	case "", ManifestPerTargetFolder:
+		basePaths, err := pluginConfig.targetFolderPaths(cfg)
+		if err != nil {
+			return err
+		}
+		dirs = basePaths
+	case ManifestPerDirectory:
+		workDirs, err := pluginConfig.workDirs(cfg)
+		if err != nil {
+			return err
+		}
+		dirs = workDirs
+	case ManifestPerLeaf:
+		basePaths, err := pluginConfig.targetFolderPaths(cfg)
+		if err != nil {
+			return err
+		}
+		for _, basePath := range basePaths {
+			dirs = append(dirs, utils.GetLeafDirs(basePath)...)
+		}
+	default:
+		return fmt.Errorf("unknown manifest scope: %s", pluginConfig.Scope)
+	}
+
+	for _, dir := range dirs {
+		count, err := utils.WriteManifest(dir, pluginConfig.Name)
+		if err != nil {
+			return fmt.Errorf("failed to write manifest in %s: %v", dir, err)
+		}
+		log.Manifests[filepath.Join(dir, pluginConfig.Name)] = count
+		totalProcessed += count
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("manifest_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeManifestLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+func writeManifestLogFile(log *ManifestLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Manifest Processing Log\n")
+	fmt.Fprintf(file, "Total files hashed: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== MANIFESTS ===\n")
+	for path, count := range log.Manifests {
+		fmt.Fprintf(file, "MANIFEST: %s (%d files)\n", path, count)
+	}
+
+	return nil
+}
+
+func (m *Manifest) GetName() string {
+	return "MANIFEST"
+}
+
+func (m *Manifest) GetDescription() string {
+	return "타겟 폴더 또는 폴더별로 모든 파일의 SHA-256 목록(SHA256SUMS)을 만듭니다. " +
+		"verify 명령으로 변경, 누락, 새 파일을 확인할 수 있습니다."
+}
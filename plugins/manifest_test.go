@@ -0,0 +1,56 @@
+package plugins
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+func TestManifestScopes(t *testing.T) {
+	tests := []struct {
+		scope    string
+		expected map[string]int // 매니페스트 폴더 (타겟 폴더 기준) -> 파일 수
+	}{
+		{ManifestPerTargetFolder, map[string]int{".": 3}},
+		{ManifestPerDirectory, map[string]int{"a": 2, "b": 1}},
+		{ManifestPerLeaf, map[string]int{"a/x": 1, "a/y": 1, "b": 1}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scope, func(t *testing.T) {
+			work := t.TempDir()
+			paper := filepath.Join(work, "paper")
+			for _, name := range []string{"a/x/1.txt", "a/y/2.txt", "b/3.txt"} {
+				path := filepath.Join(paper, filepath.FromSlash(name))
+				os.MkdirAll(filepath.Dir(path), 0755)
+				os.WriteFile(path, []byte(name), 0644)
+			}
+
+			raw, _ := json.Marshal(ManifestConfig{
+				Scope:       tc.scope,
+				TargetScope: TargetScope{TargetFolders: []string{"paper"}, Depth: 2},
+			})
+			plugin := &Manifest{pluginCfg: &config.PluginConfig{Name: "manifest", Config: raw}}
+			cfg := &config.Config{WorkPath: work, TargetDepth: 3, LogPath: t.TempDir()}
+			if err := plugin.Process(cfg); err != nil {
+				t.Fatalf("Process failed: %v", err)
+			}
+
+			manifests, err := utils.FindManifests(paper, utils.DefaultManifestName)
+			if err != nil || len(manifests) != len(tc.expected) {
+				t.Fatalf("expected %d manifests, got %v (%v)", len(tc.expected), manifests, err)
+			}
+			for dir, count := range tc.expected {
+				manifestPath := filepath.Join(paper, filepath.FromSlash(dir), utils.DefaultManifestName)
+				report, err := utils.VerifyManifest(manifestPath)
+				if err != nil || !report.Clean() || report.OK != count {
+					t.Errorf("%s: expected %d clean files, got %+v (%v)", dir, count, report, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type NameNormalizer struct {
+	pluginCfg *config.PluginConfig
+}
+
+// NameNormalizer 플러그인의 설정값 구조체
+type NameNormalizerConfig struct {
+	NFC                bool   `json:"nfc"`                   // NFD 한글 등을 NFC로 변환
+	ReplaceIllegal     bool   `json:"replace_illegal"`       // Windows에서 사용할 수 없는 문자 치환
+	Replacement        string `json:"replacement,omitempty"` // 치환 문자 (기본 "_")
+	TrimTrailing       bool   `json:"trim_trailing"`         // 끝의 점과 공백 제거
+	CollapseWhitespace bool   `json:"collapse_whitespace"`   // 연속 공백을 하나로, 앞뒤 공백 제거
+	Transliterate      bool   `json:"transliterate"`         // 라틴 문자의 악센트 제거 (é -> e)
+	MaxLength          int    `json:"max_length,omitempty"`  // 최대 길이 (바이트, 확장자 포함)
+	RenameDirs         bool   `json:"rename_dirs"`           // 폴더 이름도 변경
+
+	// 이름을 바꿀 파일 선택 조건 (폴더에는 적용하지 않음)
+	FileFilter
+
+	TargetScope
+}
+
+type NameNormalizerLog struct {
+	RenamedFiles map[string]string // 원본경로 -> 새경로
+	Collisions   []string          // 정규화 결과가 겹쳐 변경하지 않은 항목
+	TotalFiles   int
+}
+
+// Windows 파일명에 사용할 수 없는 문자
+const illegalNameChars = `:*?"<>|\`
+
+func (n *NameNormalizer) Process(cfg *config.Config) error {
+	log := &NameNormalizerLog{
+		RenamedFiles: make(map[string]string),
+	}
+
+	// 설정 구조체
+	var pluginConfig NameNormalizerConfig
+
+	// Config 파싱
+	if n.pluginCfg != nil && len(n.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(n.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	if pluginConfig.Replacement == "" {
+		pluginConfig.Replacement = "_"
+	}
+	if strings.ContainsAny(pluginConfig.Replacement, illegalNameChars+"/") {
+		return fmt.Errorf("replacement contains illegal characters: %q", pluginConfig.Replacement)
+	}
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	totalProcessed, err := normalizeWorkDirs(workDirs, pluginConfig, filter, log)
+	if err != nil {
+		return err
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("name_normalizer_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeNameNormalizerLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// normalizeWorkDirs: 작업 경로마다 이름을 정규화한다.
+// depth 범위나 leaf_dirs로 작업 경로가 겹치면 앞의 작업 경로를 처리하면서
+// 뒤의 작업 경로 이름이 바뀔 수 있으므로, 없어진 경로는 건너뛴다 (하위 항목은 이미 처리됨).
+func normalizeWorkDirs(workDirs []string, pluginConfig NameNormalizerConfig, filter *fileMatcher, log *NameNormalizerLog) (int, error) {
+	totalProcessed := 0
+	for _, dir := range workDirs {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+
+		count, err := normalizeDirNames(dir, pluginConfig, filter, log)
+		totalProcessed += count
+		if err != nil {
+			return totalProcessed, err
+		}
+	}
+	return totalProcessed, nil
+}
+
+// normalizeDirNames: dir 아래의 파일/폴더 이름을 정규화한다.
+// 하위 폴더를 먼저 처리한 뒤(bottom-up) 현재 폴더의 항목 이름을 변경한다.
+// dir 자체의 이름은 변경하지 않는다.
+func normalizeDirNames(dir string, pluginConfig NameNormalizerConfig, filter *fileMatcher, log *NameNormalizerLog) (int, error) {
+	processFileCount := 0
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return processFileCount, err
+	}
+
+	var renames []utils.RenamePair
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+
+		if entry.IsDir() {
+			count, err := normalizeDirNames(path, pluginConfig, filter, log)
+			processFileCount += count
+			if err != nil {
+				return processFileCount, err
+			}
+
+			if !pluginConfig.RenameDirs {
+				continue
+			}
+		} else if !filter.match(path, entry) {
+			continue
+		}
+
+		newName := normalizeName(entry.Name(), !entry.IsDir(), pluginConfig)
+		if newName != entry.Name() {
+			renames = append(renames, utils.RenamePair{From: path, To: filepath.Join(dir, newName)})
+		}
+	}
+
+	// 정규화 결과가 다른 항목 또는 기존 항목과 겹치면 변경하지 않음
+	renames, conflicts := utils.PlanRenames(renames)
+	log.Collisions = append(log.Collisions, conflicts...)
+
+	done, err := utils.ApplyRenames(renames)
+	for _, rename := range done {
+		log.RenamedFiles[rename.From] = rename.To
+		processFileCount++
+	}
+	if err != nil {
+		return processFileCount, err
+	}
+
+	return processFileCount, nil
+}
+
+// normalizeName: 설정에 따라 이름을 정규화한다. 결과가 비면 원래 이름을 반환한다.
+// isFile: 파일이면 max_length 적용 시 확장자를 유지한다.
+func normalizeName(name string, isFile bool, pluginConfig NameNormalizerConfig) string {
+	result := name
+
+	if pluginConfig.NFC {
+		result = norm.NFC.String(result)
+	}
+
+	if pluginConfig.Transliterate {
+		// 분해 후 결합 문자(악센트)를 제거하고 다시 결합 - 한글은 그대로 유지
+		t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+		if transliterated, _, err := transform.String(t, result); err == nil {
+			result = transliterated
+		}
+	}
+
+	if pluginConfig.ReplaceIllegal {
+		result = strings.Map(func(r rune) rune {
+			if unicode.IsControl(r) {
+				return -1
+			}
+			return r
+		}, result)
+
+		var b strings.Builder
+		for _, r := range result {
+			if strings.ContainsRune(illegalNameChars, r) {
+				b.WriteString(pluginConfig.Replacement)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+		result = b.String()
+	}
+
+	if pluginConfig.CollapseWhitespace {
+		result = strings.Join(strings.Fields(result), " ")
+	}
+
+	if pluginConfig.TrimTrailing {
+		result = strings.TrimRight(result, ". ")
+	}
+
+	if pluginConfig.MaxLength > 0 && len(result) > pluginConfig.MaxLength {
+		result = truncateName(result, isFile, pluginConfig.MaxLength)
+	}
+
+	if result == "" || result == "." || result == ".." {
+		return name
+	}
+	return result
+}
+
+// truncateName: 바이트 길이를 maxLength 이하로 줄인다. UTF-8 문자 중간에서 자르지 않는다.
+func truncateName(name string, isFile bool, maxLength int) string {
+	ext := ""
+	if isFile {
+		ext = filepath.Ext(name)
+		if len(ext) >= maxLength {
+			ext = "" // 확장자만으로 길이를 넘으면 확장자도 자름
+		}
+	}
+
+	stem := strings.TrimSuffix(name, ext)
+	limit := maxLength - len(ext)
+	for len(stem) > limit {
+		_, size := utf8.DecodeLastRuneInString(stem)
+		stem = stem[:len(stem)-size]
+	}
+
+	return strings.TrimRight(stem, " ") + ext
+}
+
+func writeNameNormalizerLogFile(log *NameNormalizerLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager NameNormalizer Processing Log\n")
+	fmt.Fprintf(file, "Total files processed: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== RENAMED FILES ===\n")
+	for original, renamed := range log.RenamedFiles {
+		fmt.Fprintf(file, "RENAMED: %s -> %s\n", original, renamed)
+	}
+
+	fmt.Fprintf(file, "\n=== COLLISIONS ===\n")
+	for _, collision := range log.Collisions {
+		fmt.Fprintf(file, "COLLISION: %s\n", collision)
+	}
+
+	return nil
+}
+
+func (n *NameNormalizer) GetName() string {
+	return "NAME_NORMALIZER"
+}
+
+func (n *NameNormalizer) GetDescription() string {
+	return "파일/폴더 이름을 정규화합니다. " +
+		"NFC 변환, Windows에서 사용할 수 없는 문자 치환, 끝의 점/공백 제거, 공백 정리, 길이 제한을 지원합니다."
+}
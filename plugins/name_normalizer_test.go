@@ -0,0 +1,89 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestNormalizeName(t *testing.T) {
+	all := NameNormalizerConfig{
+		NFC:                true,
+		ReplaceIllegal:     true,
+		Replacement:        "_",
+		TrimTrailing:       true,
+		CollapseWhitespace: true,
+	}
+
+	tests := []struct {
+		input    string
+		isFile   bool
+		config   NameNormalizerConfig
+		expected string
+	}{
+		{norm.NFD.String("퀴즈_1.pdf"), true, all, "퀴즈_1.pdf"},
+		{`report: "final"?.pdf`, true, all, "report_ _final__.pdf"},
+		{"  my   report .pdf", true, all, "my report .pdf"},
+		{"folder. . ", false, all, "folder"},
+		{"café résumé.txt", true, NameNormalizerConfig{Transliterate: true}, "cafe resume.txt"},
+		{"한글 이름.txt", true, NameNormalizerConfig{Transliterate: true}, "한글 이름.txt"},
+		{"abcdefghij.pdf", true, NameNormalizerConfig{MaxLength: 8}, "abcd.pdf"},
+		{"가나다라.txt", true, NameNormalizerConfig{MaxLength: 11}, "가나.txt"}, // 한글은 3바이트
+		{"...", false, all, "..."},                                        // 결과가 비면 원래 이름
+	}
+
+	for _, tc := range tests {
+		if got := normalizeName(tc.input, tc.isFile, tc.config); got != tc.expected {
+			t.Errorf("input: %q, expected: %q, got: %q", tc.input, tc.expected, got)
+		}
+	}
+}
+
+func TestNormalizeDirNamesCollision(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "sub?"), 0755)
+	os.WriteFile(filepath.Join(dir, "sub?", "a:b.txt"), nil, 0644)
+	os.WriteFile(filepath.Join(dir, "x?.txt"), nil, 0644)
+	os.WriteFile(filepath.Join(dir, "x*.txt"), nil, 0644) // x_.txt로 겹침
+
+	pluginConfig := NameNormalizerConfig{ReplaceIllegal: true, Replacement: "_", RenameDirs: true}
+	log := &NameNormalizerLog{RenamedFiles: make(map[string]string)}
+	filter, _ := pluginConfig.FileFilter.compile(time.Now())
+
+	if _, err := normalizeDirNames(dir, pluginConfig, filter, log); err != nil {
+		t.Fatalf("normalizeDirNames failed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "sub_", "a_b.txt")); err != nil {
+		t.Errorf("expected nested file and folder to be renamed: %v", err)
+	}
+	if len(log.Collisions) != 2 {
+		t.Errorf("expected 2 collisions, got %v", log.Collisions)
+	}
+}
+
+func TestNormalizeWorkDirsOverlapping(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "a?", "b?"), 0755)
+	os.WriteFile(filepath.Join(dir, "a?", "b?", "c?.txt"), nil, 0644)
+
+	// min_depth/max_depth 범위처럼 부모와 자식 폴더가 모두 작업 경로
+	workDirs := []string{dir, filepath.Join(dir, "a?"), filepath.Join(dir, "a?", "b?")}
+	pluginConfig := NameNormalizerConfig{ReplaceIllegal: true, Replacement: "_", RenameDirs: true}
+	log := &NameNormalizerLog{RenamedFiles: make(map[string]string)}
+	filter, _ := pluginConfig.FileFilter.compile(time.Now())
+
+	count, err := normalizeWorkDirs(workDirs, pluginConfig, filter, log)
+	if err != nil {
+		t.Fatalf("normalizeWorkDirs failed: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("expected 3 renames, got %d (%v)", count, log.RenamedFiles)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "a_", "b_", "c_.txt")); err != nil {
+		t.Errorf("expected all levels renamed: %v", err)
+	}
+}
@@ -0,0 +1,266 @@
+package plugins
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/utils"
+)
+
+// 버전 종류
+const (
+	VersionInteger = "integer" // 1, 2, 10
+	VersionPadded  = "padded"  // 001, 002, 010
+	VersionSemver  = "semver"  // 1.2.0
+	VersionDate    = "date"    // 2024-05-01 (date_layout)
+)
+
+const defaultDateLayout = "2006-01-02"
+
+// NamePattern underscore_number 파일명 패턴 설정
+// separator 또는 regex 중 하나로 prefix와 버전을 나눈다.
+// 내장 패턴 이름만 지정하면 내장 설정을 사용한다.
+type NamePattern struct {
+	Name       string `json:"name"`
+	Separator  string `json:"separator,omitempty"`   // 마지막 구분자 기준으로 prefix/버전 분리
+	Regex      string `json:"regex,omitempty"`       // prefix, version 그룹을 포함한 정규식 (확장자 제외)
+	Version    string `json:"version,omitempty"`     // integer, padded, semver, date
+	DateLayout string `json:"date_layout,omitempty"` // version이 date일 때 형식
+}
+
+// builtinNamePatterns 내장 패턴
+var builtinNamePatterns = map[string]NamePattern{
+	"underscore": {Name: "underscore", Separator: "_", Version: VersionInteger},                                       // report_3.pdf
+	"dash_v":     {Name: "dash_v", Regex: `^(?P<prefix>.+)-v(?P<version>\d+)$`, Version: VersionInteger},              // report-v3.pdf
+	"paren":      {Name: "paren", Regex: `^(?P<prefix>.+?) ?\((?P<version>\d+)\)$`, Version: VersionInteger},          // report (2).pdf
+	"padded":     {Name: "padded", Separator: "_", Version: VersionPadded},                                            // report_003.pdf
+	"semver":     {Name: "semver", Regex: `^(?P<prefix>.+)[_-]v?(?P<version>\d+(?:\.\d+)+)$`, Version: VersionSemver}, // report_v1.2.pdf
+	"date":       {Name: "date", Separator: "_", Version: VersionDate, DateLayout: defaultDateLayout},                 // report_2024-05-01.pdf
+}
+
+// defaultNamePattern 패턴 설정이 없을 때 사용 (prefix_숫자.확장자)
+var defaultNamePattern = mustCompileNamePattern(builtinNamePatterns["underscore"])
+
+// Version 파일명에서 읽은 버전
+type Version struct {
+	Raw   string
+	Kind  string
+	parts []int64
+	date  time.Time
+}
+
+// Compare 버전 비교 (-1, 0, 1)
+func (v Version) Compare(other Version) int {
+	if v.Kind == VersionDate {
+		return v.date.Compare(other.date)
+	}
+
+	for i := 0; i < max(len(v.parts), len(other.parts)); i++ {
+		var a, b int64
+		if i < len(v.parts) {
+			a = v.parts[i]
+		}
+		if i < len(other.parts) {
+			b = other.parts[i]
+		}
+		if a != b {
+			if a < b {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// Number integer/padded 버전의 숫자 값
+func (v Version) Number() int64 {
+	if len(v.parts) == 0 {
+		return 0
+	}
+	return v.parts[0]
+}
+
+// parsedName 패턴에 매칭된 파일명
+type parsedName struct {
+	Prefix  string
+	Version Version
+	Ext     string
+	Pattern string // 매칭된 패턴 이름
+}
+
+// namePattern 컴파일된 NamePattern
+type namePattern struct {
+	NamePattern
+	re *regexp.Regexp
+}
+
+// compileNamePatterns 설정의 패턴 목록을 컴파일한다. 설정이 없으면 기본 패턴만 사용한다.
+func compileNamePatterns(patterns []NamePattern) ([]*namePattern, error) {
+	if len(patterns) == 0 {
+		return []*namePattern{defaultNamePattern}, nil
+	}
+
+	compiled := make([]*namePattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		// 이름만 지정한 내장 패턴
+		if pattern.Separator == "" && pattern.Regex == "" {
+			builtin, ok := builtinNamePatterns[pattern.Name]
+			if !ok {
+				return nil, fmt.Errorf("unknown name pattern: %s", pattern.Name)
+			}
+			pattern = builtin
+		}
+
+		p, err := compileNamePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, p)
+	}
+	return compiled, nil
+}
+
+func compileNamePattern(pattern NamePattern) (*namePattern, error) {
+	if pattern.Version == "" {
+		pattern.Version = VersionInteger
+	}
+	switch pattern.Version {
+	case VersionInteger, VersionPadded, VersionSemver:
+	case VersionDate:
+		if pattern.DateLayout == "" {
+			pattern.DateLayout = defaultDateLayout
+		}
+	default:
+		return nil, fmt.Errorf("name pattern %s: unknown version kind: %s", pattern.Name, pattern.Version)
+	}
+
+	p := &namePattern{NamePattern: pattern}
+	if pattern.Regex != "" {
+		re, err := regexp.Compile(pattern.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("name pattern %s: invalid regex: %v", pattern.Name, err)
+		}
+		if re.SubexpIndex("prefix") < 0 || re.SubexpIndex("version") < 0 {
+			return nil, fmt.Errorf("name pattern %s: regex needs prefix and version groups", pattern.Name)
+		}
+		p.re = re
+	}
+	return p, nil
+}
+
+func mustCompileNamePattern(pattern NamePattern) *namePattern {
+	p, err := compileNamePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// match 파일명을 prefix, 버전, 확장자로 나눈다.
+// 파일명은 NFC로 정규화한 뒤 매칭하므로 prefix는 항상 NFC이다.
+// 패턴에 맞지 않으면 ok가 false, 패턴에는 맞지만 버전을 읽을 수 없으면 err를 반환한다.
+func (p *namePattern) match(filename string) (parsed parsedName, ok bool, err error) {
+	filename = utils.NormalizeName(filename)
+
+	// 확장자 분리
+	ext := filepath.Ext(filename)
+	nameWithoutExt := strings.TrimSuffix(filename, ext)
+
+	var prefix, versionStr string
+	if p.re != nil {
+		groups := p.re.FindStringSubmatch(nameWithoutExt)
+		if groups == nil {
+			return parsedName{}, false, nil
+		}
+		prefix = groups[p.re.SubexpIndex("prefix")]
+		versionStr = groups[p.re.SubexpIndex("version")]
+	} else {
+		index := strings.LastIndex(nameWithoutExt, p.Separator)
+		if index == -1 {
+			return parsedName{}, false, nil
+		}
+		prefix = nameWithoutExt[:index]
+		versionStr = nameWithoutExt[index+len(p.Separator):]
+	}
+
+	if prefix == "" || versionStr == "" {
+		return parsedName{}, false, nil
+	}
+
+	version, ok, err := p.parseVersion(versionStr)
+	if !ok {
+		return parsedName{}, false, nil
+	}
+	if err != nil {
+		// 버전 외의 정보는 채워서 호출하는 쪽이 같은 그룹을 알 수 있게 함
+		return parsedName{Prefix: prefix, Ext: ext, Pattern: p.Name}, true, err
+	}
+
+	return parsedName{Prefix: prefix, Version: version, Ext: ext, Pattern: p.Name}, true, nil
+}
+
+// parseVersion 버전 문자열 해석
+// 형식이 다르면 ok가 false, 형식은 맞지만 값이 잘못되면(숫자 범위 초과 등) err를 반환한다.
+func (p *namePattern) parseVersion(s string) (Version, bool, error) {
+	version := Version{Raw: s, Kind: p.Version}
+
+	switch p.Version {
+	case VersionDate:
+		date, err := time.Parse(p.DateLayout, s)
+		if err != nil {
+			return Version{}, false, nil
+		}
+		version.date = date
+		return version, true, nil
+	case VersionSemver:
+		for _, part := range strings.Split(s, ".") {
+			if !isDigits(part) {
+				return Version{}, false, nil
+			}
+			n, err := strconv.ParseInt(part, 10, 64)
+			if err != nil {
+				return Version{}, true, fmt.Errorf("version %q out of range", s)
+			}
+			version.parts = append(version.parts, n)
+		}
+		return version, true, nil
+	default: // integer, padded
+		if !isDigits(s) {
+			return Version{}, false, nil
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return Version{}, true, fmt.Errorf("version %q out of range", s)
+		}
+		version.parts = []int64{n}
+		return version, true, nil
+	}
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseName 패턴 목록 순서대로 파일명을 해석한다. 처음 매칭된 패턴을 사용한다.
+func parseName(filename string, patterns []*namePattern) (parsedName, bool, error) {
+	for _, pattern := range patterns {
+		parsed, ok, err := pattern.match(filename)
+		if ok {
+			return parsed, true, err
+		}
+	}
+	return parsedName{}, false, nil
+}
@@ -0,0 +1,272 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+)
+
+type OrganizeByDate struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 날짜를 읽을 위치
+const (
+	DateSourceModTime  = "mtime"    // 파일 수정 시간
+	DateSourceFilename = "filename" // 파일명의 날짜 (2024-05-01, 20240501, 2024_05)
+	DateSourceFolder   = "folder"   // 상위 폴더 이름의 날짜
+)
+
+const defaultDateFolderLayout = "2006/01"
+
+// 연도(19xx, 20xx) + 월 + 일(선택), 구분자는 없거나 - _ .
+var embeddedDateRegex = regexp.MustCompile(`(?:^|\D)((?:19|20)\d{2})[-_.]?(0[1-9]|1[0-2])(?:[-_.]?(0[1-9]|[12]\d|3[01]))?(?:\D|$)`)
+
+// OrganizeByDate 플러그인의 설정값 구조체
+type OrganizeByDateConfig struct {
+	DateSources    []string `json:"date_sources,omitempty"` // 순서대로 시도 (기본 mtime)
+	Layout         string   `json:"layout,omitempty"`       // 폴더 형식 (Go 시간 형식, 기본 2006/01)
+	TimeZone       string   `json:"time_zone,omitempty"`    // 예: Asia/Seoul (기본 시스템 시간대)
+	SearchSubdirs  bool     `json:"search_subdirs"`         // 하위 폴더까지 검색 여부
+	OverwriteFiles bool     `json:"overwrite_files"`        // 이동할 위치에 이미 파일이 있다면 덮어쓰기 여부
+
+	// 이동할 파일 선택 조건
+	FileFilter
+
+	// 정리할 타겟 폴더와 깊이 - depth 폴더 아래에 날짜 폴더 생성
+	TargetScope
+}
+
+type OrganizeByDateLog struct {
+	MovedFiles   map[string]string // 원본경로 -> 대상경로
+	SkippedFiles []string          // 대상 위치에 같은 이름의 파일이 있어 건너뜀
+	UndatedFiles []string          // 날짜를 찾지 못한 파일
+	FailedMoves  []string          // 실패한 파일 (전체 경로)
+	TotalFiles   int
+}
+
+func (o *OrganizeByDate) Process(cfg *config.Config) error {
+	totalProcessed := 0
+	log := &OrganizeByDateLog{
+		MovedFiles: make(map[string]string),
+	}
+
+	// 설정 구조체
+	var pluginConfig OrganizeByDateConfig
+
+	// Config 파싱
+	if o.pluginCfg != nil && len(o.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(o.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	if pluginConfig.Layout == "" {
+		pluginConfig.Layout = defaultDateFolderLayout
+	}
+	if len(pluginConfig.DateSources) == 0 {
+		pluginConfig.DateSources = []string{DateSourceModTime}
+	}
+	for _, source := range pluginConfig.DateSources {
+		switch source {
+		case DateSourceModTime, DateSourceFilename, DateSourceFolder:
+		default:
+			return fmt.Errorf("unknown date source: %s", source)
+		}
+	}
+
+	location := time.Local
+	if pluginConfig.TimeZone != "" {
+		loc, err := time.LoadLocation(pluginConfig.TimeZone)
+		if err != nil {
+			return fmt.Errorf("invalid time_zone: %v", err)
+		}
+		location = loc
+	}
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range workDirs {
+		count, err := organizeDirByDate(dir, pluginConfig, filter, location, log)
+		totalProcessed += count
+		if err != nil {
+			return err
+		}
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("organize_by_date_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeOrganizeByDateLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// organizeDirByDate: workDir의 파일을 workDir/<날짜 폴더>로 이동한다.
+func organizeDirByDate(workDir string, pluginConfig OrganizeByDateConfig, filter *fileMatcher, location *time.Location, log *OrganizeByDateLog) (int, error) {
+	processFileCount := 0
+
+	// 이동하면서 새 폴더가 생기므로 파일 목록을 먼저 만든다
+	var files []string
+	if pluginConfig.SearchSubdirs {
+		err := filepath.WalkDir(workDir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			if filter.match(path, d) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return processFileCount, err
+		}
+	} else {
+		entries, err := os.ReadDir(workDir)
+		if err != nil {
+			return processFileCount, err
+		}
+		for _, entry := range entries {
+			path := filepath.Join(workDir, entry.Name())
+			if !entry.IsDir() && filter.match(path, entry) {
+				files = append(files, path)
+			}
+		}
+	}
+
+	for _, path := range files {
+		date, ok := fileDate(path, pluginConfig.DateSources, location)
+		if !ok {
+			log.UndatedFiles = append(log.UndatedFiles, path)
+			continue
+		}
+
+		// FileRelocator와 같은 방식으로 이동 - 대상 폴더만 파일마다 다름
+		targetDirPath := filepath.Join(workDir, filepath.FromSlash(date.Format(pluginConfig.Layout)))
+		targetPath, moved, err := moveFile(path, targetDirPath, true, pluginConfig.OverwriteFiles)
+		if err != nil {
+			if !moved {
+				return processFileCount, err
+			}
+			log.FailedMoves = append(log.FailedMoves, path)
+			continue
+		}
+
+		if moved {
+			log.MovedFiles[path] = targetPath
+			processFileCount++
+		} else if targetPath != path {
+			log.SkippedFiles = append(log.SkippedFiles, path)
+		}
+	}
+
+	return processFileCount, nil
+}
+
+// fileDate: date_sources 순서대로 파일의 날짜를 찾는다.
+func fileDate(path string, sources []string, location *time.Location) (time.Time, bool) {
+	for _, source := range sources {
+		switch source {
+		case DateSourceModTime:
+			if info, err := os.Stat(path); err == nil {
+				return info.ModTime().In(location), true
+			}
+		case DateSourceFilename:
+			if date, ok := parseEmbeddedDate(filepath.Base(path), location); ok {
+				return date, true
+			}
+		case DateSourceFolder:
+			if date, ok := parseEmbeddedDate(filepath.Base(filepath.Dir(path)), location); ok {
+				return date, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseEmbeddedDate: 이름에 포함된 날짜를 읽는다. 일이 없으면 1일로 한다.
+func parseEmbeddedDate(name string, location *time.Location) (time.Time, bool) {
+	groups := embeddedDateRegex.FindStringSubmatch(name)
+	if groups == nil {
+		return time.Time{}, false
+	}
+
+	year, _ := strconv.Atoi(groups[1])
+	month, _ := strconv.Atoi(groups[2])
+	day := 1
+	if groups[3] != "" {
+		day, _ = strconv.Atoi(groups[3])
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, location)
+	if date.Day() != day {
+		return time.Time{}, false // 2월 30일 등
+	}
+	return date, true
+}
+
+func writeOrganizeByDateLogFile(log *OrganizeByDateLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager OrganizeByDate Processing Log\n")
+	fmt.Fprintf(file, "Total files processed: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== MOVED FILES ===\n")
+	for original, moved := range log.MovedFiles {
+		fmt.Fprintf(file, "MOVED: %s -> %s\n", original, moved)
+	}
+
+	fmt.Fprintf(file, "\n=== SKIPPED FILES (TARGET EXISTS) ===\n")
+	for _, skipped := range log.SkippedFiles {
+		fmt.Fprintf(file, "SKIPPED: %s\n", skipped)
+	}
+
+	fmt.Fprintf(file, "\n=== UNDATED FILES ===\n")
+	for _, undated := range log.UndatedFiles {
+		fmt.Fprintf(file, "UNDATED: %s\n", undated)
+	}
+
+	fmt.Fprintf(file, "\n=== FAILED MOVES ===\n")
+	for _, failed := range log.FailedMoves {
+		fmt.Fprintf(file, "FAILED: %s\n", failed)
+	}
+
+	return nil
+}
+
+func (o *OrganizeByDate) GetName() string {
+	return "ORGANIZE_BY_DATE"
+}
+
+func (o *OrganizeByDate) GetDescription() string {
+	return "파일을 날짜별 폴더(기본 YYYY/MM)로 이동합니다. " +
+		"날짜는 수정 시간, 파일명 또는 상위 폴더 이름에서 읽습니다."
+}
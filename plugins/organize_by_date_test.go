@@ -0,0 +1,59 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseEmbeddedDate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string // 2006-01-02, 없으면 ""
+	}{
+		{"report_2024-05-01.pdf", "2024-05-01"},
+		{"scan20231231.jpg", "2023-12-31"},
+		{"2024_03", "2024-03-01"},
+		{"photo_2024.05.17_final.png", "2024-05-17"},
+		{"report_2024-02-30.pdf", ""}, // 없는 날짜
+		{"id_120240501.txt", ""},      // 더 긴 숫자의 일부
+		{"quiz_3.pdf", ""},
+	}
+
+	for _, tc := range tests {
+		date, ok := parseEmbeddedDate(tc.input, time.UTC)
+		got := ""
+		if ok {
+			got = date.Format("2006-01-02")
+		}
+		if got != tc.expected {
+			t.Errorf("input: %q, expected: %q, got: %q", tc.input, tc.expected, got)
+		}
+	}
+}
+
+func TestOrganizeDirByDate(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "report_2024-05-01.pdf"), nil, 0644)
+	os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0644)
+	modTime := time.Date(2023, 11, 2, 12, 0, 0, 0, time.UTC)
+	os.Chtimes(filepath.Join(dir, "notes.txt"), modTime, modTime)
+
+	pluginConfig := OrganizeByDateConfig{
+		DateSources: []string{DateSourceFilename, DateSourceModTime},
+		Layout:      "2006/01",
+	}
+	log := &OrganizeByDateLog{MovedFiles: make(map[string]string)}
+	filter, _ := pluginConfig.FileFilter.compile(time.Now())
+
+	if _, err := organizeDirByDate(dir, pluginConfig, filter, time.UTC, log); err != nil {
+		t.Fatalf("organizeDirByDate failed: %v", err)
+	}
+
+	for _, path := range []string{"2024/05/report_2024-05-01.pdf", "2023/11/notes.txt"} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(path))); err != nil {
+			t.Errorf("expected %s: %v", path, err)
+		}
+	}
+}
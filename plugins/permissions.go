@@ -0,0 +1,309 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Permissions struct {
+	pluginCfg *config.PluginConfig
+}
+
+// PermissionRule: 권한 설정 한 건 - 빈 값은 상위 설정(기본값)을 사용
+type PermissionRule struct {
+	Pattern        string   `json:"pattern,omitempty"`         // 이름 또는 depth 폴더 기준 상대 경로 glob
+	FileExtensions []string `json:"file_extensions,omitempty"` // 파일 확장자 (폴더에는 적용하지 않음)
+	FileMode       string   `json:"file_mode,omitempty"`       // 8진수 (예: "0644")
+	DirMode        string   `json:"dir_mode,omitempty"`        // 8진수 (예: "0755")
+	UID            *int     `json:"uid,omitempty"`             // root로 실행할 때만 적용
+	GID            *int     `json:"gid,omitempty"`
+}
+
+// Permissions 플러그인의 설정값 구조체
+type PermissionsConfig struct {
+	PermissionRule                  // 기본값 (pattern, file_extensions는 무시)
+	Rules          []PermissionRule `json:"rules,omitempty"` // 앞에 있는 규칙부터 처음 일치한 규칙 적용
+
+	// 권한을 바꿀 타겟 폴더와 깊이 - depth 폴더와 그 아래 모든 항목
+	TargetScope
+}
+
+type PermissionsLog struct {
+	Changes      []string // "경로: 변경 내용"
+	Failed       []string // 실패한 항목과 이유
+	ChownSkipped bool     // root가 아니라 uid/gid를 적용하지 않음
+	TotalFiles   int
+}
+
+// resolvedPermission: 항목 하나에 적용할 최종 값
+type resolvedPermission struct {
+	mode     fs.FileMode
+	hasMode  bool
+	uid, gid int // -1이면 변경하지 않음
+}
+
+func (p *Permissions) Process(cfg *config.Config) error {
+	log := &PermissionsLog{}
+
+	// 설정 구조체
+	var pluginConfig PermissionsConfig
+
+	// Config 파싱
+	if p.pluginCfg != nil && len(p.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(p.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	// 모드 문자열 미리 확인
+	for _, rule := range append([]PermissionRule{pluginConfig.PermissionRule}, pluginConfig.Rules...) {
+		for _, mode := range []string{rule.FileMode, rule.DirMode} {
+			if _, _, err := parseMode(mode); err != nil {
+				return err
+			}
+		}
+		if rule.Pattern != "" {
+			if _, err := filepath.Match(rule.Pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q: %v", rule.Pattern, err)
+			}
+		}
+	}
+
+	// uid/gid 변경은 root 권한이 필요
+	canChown := os.Geteuid() == 0
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, dir := range workDirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if seen[path] || d.Type()&fs.ModeSymlink != 0 {
+				return nil // 심볼릭 링크는 대상 파일이 바뀌므로 건너뜀
+			}
+			seen[path] = true
+
+			rel, _ := filepath.Rel(dir, path)
+			perm := resolvePermission(pluginConfig, rel, d)
+			if !canChown && (perm.uid >= 0 || perm.gid >= 0) {
+				log.ChownSkipped = true
+				perm.uid, perm.gid = -1, -1
+			}
+
+			changed, err := applyPermission(path, perm)
+			if err != nil {
+				log.Failed = append(log.Failed, fmt.Sprintf("%s: %v", path, err))
+				return nil
+			}
+			if changed != "" {
+				log.Changes = append(log.Changes, fmt.Sprintf("%s: %s", path, changed))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.TotalFiles = len(log.Changes)
+
+	logFileName := fmt.Sprintf("permissions_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writePermissionsLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// parseMode: "0644" 같은 8진수 문자열. 빈 문자열이면 ok가 false
+func parseMode(mode string) (fs.FileMode, bool, error) {
+	if mode == "" {
+		return 0, false, nil
+	}
+	value, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || value > 0o7777 {
+		return 0, false, fmt.Errorf("invalid mode: %q", mode)
+	}
+	return fs.FileMode(value), true, nil
+}
+
+// resolvePermission: 처음 일치한 규칙의 값을 사용하고, 규칙에 없는 값은 기본값을 사용한다.
+func resolvePermission(pluginConfig PermissionsConfig, rel string, d fs.DirEntry) resolvedPermission {
+	rule := pluginConfig.PermissionRule
+	for _, candidate := range pluginConfig.Rules {
+		if ruleMatches(candidate, rel, d) {
+			if candidate.FileMode == "" {
+				candidate.FileMode = rule.FileMode
+			}
+			if candidate.DirMode == "" {
+				candidate.DirMode = rule.DirMode
+			}
+			if candidate.UID == nil {
+				candidate.UID = rule.UID
+			}
+			if candidate.GID == nil {
+				candidate.GID = rule.GID
+			}
+			rule = candidate
+			break
+		}
+	}
+
+	perm := resolvedPermission{uid: -1, gid: -1}
+	mode := rule.FileMode
+	if d.IsDir() {
+		mode = rule.DirMode
+	}
+	perm.mode, perm.hasMode, _ = parseMode(mode)
+	if rule.UID != nil {
+		perm.uid = *rule.UID
+	}
+	if rule.GID != nil {
+		perm.gid = *rule.GID
+	}
+	return perm
+}
+
+// ruleMatches: pattern은 이름 또는 상대 경로, file_extensions는 파일에만 적용
+func ruleMatches(rule PermissionRule, rel string, d fs.DirEntry) bool {
+	if len(rule.FileExtensions) > 0 && (d.IsDir() || !utils.HasExtension(d.Name(), rule.FileExtensions)) {
+		return false
+	}
+	if rule.Pattern != "" {
+		pattern := utils.NormalizeName(filepath.ToSlash(rule.Pattern))
+		nameMatched, _ := filepath.Match(pattern, utils.NormalizeName(d.Name()))
+		relMatched, _ := filepath.Match(pattern, utils.NormalizeName(filepath.ToSlash(rel)))
+		if !nameMatched && !relMatched {
+			return false
+		}
+	}
+	return true
+}
+
+// applyPermission: 모드와 소유자를 바꾸고 변경 내용을 반환한다. 바뀐 것이 없으면 ""
+func applyPermission(path string, perm resolvedPermission) (string, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return "", err
+	}
+
+	changed := ""
+	current := info.Mode() & (fs.ModePerm | fs.ModeSetuid | fs.ModeSetgid | fs.ModeSticky)
+	if perm.hasMode && unixPermBits(current) != uint32(perm.mode) {
+		if err := os.Chmod(path, osFileMode(perm.mode)); err != nil {
+			return "", err
+		}
+		changed = fmt.Sprintf("mode %04o -> %04o", unixPermBits(current), uint32(perm.mode))
+	}
+
+	if perm.uid >= 0 || perm.gid >= 0 {
+		uid, gid, ok := fileOwner(info)
+		if !ok {
+			return changed, fmt.Errorf("owner not supported on this platform")
+		}
+		newUID, newGID := uid, gid
+		if perm.uid >= 0 {
+			newUID = perm.uid
+		}
+		if perm.gid >= 0 {
+			newGID = perm.gid
+		}
+		if newUID != uid || newGID != gid {
+			if err := os.Lchown(path, newUID, newGID); err != nil {
+				return changed, err
+			}
+			if changed != "" {
+				changed += ", "
+			}
+			changed += fmt.Sprintf("owner %d:%d -> %d:%d", uid, gid, newUID, newGID)
+		}
+	}
+
+	return changed, nil
+}
+
+// unixPermBits: Go FileMode를 chmod 8진수 값으로 (setuid 등 포함)
+func unixPermBits(mode fs.FileMode) uint32 {
+	bits := uint32(mode & fs.ModePerm)
+	if mode&fs.ModeSetuid != 0 {
+		bits |= 0o4000
+	}
+	if mode&fs.ModeSetgid != 0 {
+		bits |= 0o2000
+	}
+	if mode&fs.ModeSticky != 0 {
+		bits |= 0o1000
+	}
+	return bits
+}
+
+// osFileMode: chmod 8진수 값을 os.Chmod에 넘길 FileMode로
+func osFileMode(bits fs.FileMode) fs.FileMode {
+	mode := bits & fs.ModePerm
+	if bits&0o4000 != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if bits&0o2000 != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if bits&0o1000 != 0 {
+		mode |= fs.ModeSticky
+	}
+	return mode
+}
+
+func writePermissionsLogFile(log *PermissionsLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Permissions Processing Log\n")
+	fmt.Fprintf(file, "Total items changed: %d\n", log.TotalFiles)
+	if log.ChownSkipped {
+		fmt.Fprintf(file, "Warning: uid/gid not applied (requires root)\n")
+	}
+	fmt.Fprintf(file, "\n")
+
+	fmt.Fprintf(file, "=== CHANGES ===\n")
+	for _, change := range log.Changes {
+		fmt.Fprintf(file, "CHANGED: %s\n", change)
+	}
+
+	fmt.Fprintf(file, "\n=== FAILED ===\n")
+	for _, failed := range log.Failed {
+		fmt.Fprintf(file, "FAILED: %s\n", failed)
+	}
+
+	return nil
+}
+
+func (p *Permissions) GetName() string {
+	return "PERMISSIONS"
+}
+
+func (p *Permissions) GetDescription() string {
+	return "타겟 폴더의 파일/폴더 권한(모드)을 확장자나 경로 패턴별로 설정합니다. " +
+		"root로 실행하면 소유자(uid/gid)도 변경할 수 있습니다."
+}
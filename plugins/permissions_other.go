@@ -0,0 +1,10 @@
+//go:build !unix
+
+package plugins
+
+import "io/fs"
+
+// fileOwner: uid/gid가 없는 플랫폼 (Windows)
+func fileOwner(info fs.FileInfo) (int, int, bool) {
+	return 0, 0, false
+}
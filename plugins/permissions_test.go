@@ -0,0 +1,70 @@
+package plugins
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveAndApplyPermission(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "scripts"), 0777)
+	os.WriteFile(filepath.Join(dir, "report.pdf"), nil, 0755)
+	os.WriteFile(filepath.Join(dir, "scripts", "run.sh"), nil, 0644)
+	os.Chmod(filepath.Join(dir, "scripts"), 0777)
+
+	pluginConfig := PermissionsConfig{
+		PermissionRule: PermissionRule{FileMode: "0644", DirMode: "0755"},
+		Rules: []PermissionRule{
+			{Pattern: "scripts/*.sh", FileMode: "0750"},
+		},
+	}
+
+	expected := map[string]fs.FileMode{
+		"report.pdf":     0644,
+		"scripts":        0755,
+		"scripts/run.sh": 0750,
+	}
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		if _, err := applyPermission(path, resolvePermission(pluginConfig, rel, d)); err != nil {
+			t.Errorf("%s: %v", rel, err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for rel, mode := range expected {
+		info, err := os.Stat(filepath.Join(dir, filepath.FromSlash(rel)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != mode {
+			t.Errorf("%s: expected %04o, got %04o", rel, mode, info.Mode().Perm())
+		}
+	}
+
+	// 이미 같은 모드면 변경 없음
+	changed, _ := applyPermission(filepath.Join(dir, "report.pdf"), resolvedPermission{mode: 0644, hasMode: true, uid: -1, gid: -1})
+	if changed != "" {
+		t.Errorf("expected no change, got %q", changed)
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	if mode, ok, err := parseMode("2775"); err != nil || !ok || mode != 0o2775 {
+		t.Errorf("parseMode(2775): %o, %v, %v", mode, ok, err)
+	}
+	for _, invalid := range []string{"0999", "rwx", "17777"} {
+		if _, _, err := parseMode(invalid); err == nil {
+			t.Errorf("parseMode(%q): expected error", invalid)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+//go:build unix
+
+package plugins
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+// fileOwner: 파일의 uid, gid
+func fileOwner(info fs.FileInfo) (int, int, bool) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, 0, false
+	}
+	return int(stat.Uid), int(stat.Gid), true
+}
@@ -12,6 +12,38 @@ func GetPlugin(pluginCfg *config.PluginConfig) (Plugin, error) {
 		return &UnderscoreNumber{pluginCfg: pluginCfg}, nil
 	case "file_relocator":
 		return &FileRelocator{pluginCfg: pluginCfg}, nil
+	case "name_normalizer":
+		return &NameNormalizer{pluginCfg: pluginCfg}, nil
+	case "dedupe":
+		return &Dedupe{pluginCfg: pluginCfg}, nil
+	case "organize_by_date":
+		return &OrganizeByDate{pluginCfg: pluginCfg}, nil
+	case "retention":
+		return &Retention{pluginCfg: pluginCfg}, nil
+	case "empty_dirs":
+		return &EmptyDirs{pluginCfg: pluginCfg}, nil
+	case "archive":
+		return &Archive{pluginCfg: pluginCfg}, nil
+	case "extract":
+		return &Extract{pluginCfg: pluginCfg}, nil
+	case "encoding":
+		return &Encoding{pluginCfg: pluginCfg}, nil
+	case "rename":
+		return &Rename{pluginCfg: pluginCfg}, nil
+	case "flatten":
+		return &Flatten{pluginCfg: pluginCfg}, nil
+	case "split":
+		return &Split{pluginCfg: pluginCfg}, nil
+	case "extensions":
+		return &Extensions{pluginCfg: pluginCfg}, nil
+	case "index":
+		return &Index{pluginCfg: pluginCfg}, nil
+	case "manifest":
+		return &Manifest{pluginCfg: pluginCfg}, nil
+	case "permissions":
+		return &Permissions{pluginCfg: pluginCfg}, nil
+	case "large_files":
+		return &LargeFiles{pluginCfg: pluginCfg}, nil
 	default:
 		return nil, fmt.Errorf("unknown plugin: %s", pluginCfg.Name)
 	}
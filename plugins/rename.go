@@ -0,0 +1,439 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Rename struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 순번 초기화 단위
+const (
+	SeqResetDirectory = "directory" // 폴더마다 seq_start부터 (기본값)
+	SeqResetNone      = "none"      // 전체 파일에 이어서
+)
+
+// 충돌 처리
+const (
+	CollisionSkip  = "skip"  // 충돌한 파일만 변경하지 않음 (기본값)
+	CollisionAbort = "abort" // 하나라도 충돌하면 아무것도 변경하지 않음
+)
+
+const defaultRenameDateLayout = "20060102"
+
+// {필드[:인자][|대소문자]} - 예: {1}, {seq:3}, {date:2006-01}, {parent|upper}
+var renamePlaceholderRegex = regexp.MustCompile(`\{([^{}:|]+)(?::([^{}|]*))?(?:\|([a-z]+))?\}`)
+
+// Rename 플러그인의 설정값 구조체
+type RenameConfig struct {
+	// 파일 선택 - match와 glob 중 하나, 둘 다 없으면 모든 파일 (공통 선택 조건은 FileFilter)
+	Match         string `json:"match,omitempty"` // 파일명 정규식 (캡처 그룹을 템플릿에서 사용)
+	Glob          string `json:"glob,omitempty"`  // 파일명 glob 패턴
+	SearchSubdirs bool   `json:"search_subdirs"`  // 하위 폴더까지 검색 여부
+
+	Template    string `json:"template"`               // 새 파일명 템플릿
+	SeqStart    *int   `json:"seq_start,omitempty"`    // 순번 시작값 (기본 1)
+	SeqReset    string `json:"seq_reset,omitempty"`    // directory, none
+	OnCollision string `json:"on_collision,omitempty"` // skip, abort
+
+	// 대상 파일 선택 조건
+	FileFilter
+
+	// 이름을 변경할 타겟 폴더와 깊이
+	TargetScope
+}
+
+type RenameLog struct {
+	RenamedFiles map[string]string // 원본경로 -> 새경로
+	Collisions   []string          // 충돌로 변경하지 않은 항목
+	Aborted      bool              // on_collision: abort로 전체 취소
+	TotalFiles   int
+}
+
+// renameTarget: 이름을 바꿀 파일 한 건과 템플릿 값
+type renameTarget struct {
+	path     string
+	captures []string
+	names    []string // 정규식 그룹 이름
+	seq      int
+}
+
+func (r *Rename) Process(cfg *config.Config) error {
+	log := &RenameLog{
+		RenamedFiles: make(map[string]string),
+	}
+
+	// 설정 구조체
+	var pluginConfig RenameConfig
+
+	// Config 파싱
+	if r.pluginCfg != nil && len(r.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(r.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	if pluginConfig.Template == "" {
+		return fmt.Errorf("template is required")
+	}
+	if pluginConfig.Match != "" && pluginConfig.Glob != "" {
+		return fmt.Errorf("use either match or glob, not both")
+	}
+	if pluginConfig.Glob != "" {
+		if _, err := filepath.Match(pluginConfig.Glob, ""); err != nil {
+			return fmt.Errorf("invalid glob: %v", err)
+		}
+	}
+	if err := validateRenameTemplate(pluginConfig.Template); err != nil {
+		return err
+	}
+
+	switch pluginConfig.SeqReset {
+	case "":
+		pluginConfig.SeqReset = SeqResetDirectory
+	case SeqResetDirectory, SeqResetNone:
+	default:
+		return fmt.Errorf("unknown seq_reset: %s", pluginConfig.SeqReset)
+	}
+
+	switch pluginConfig.OnCollision {
+	case "":
+		pluginConfig.OnCollision = CollisionSkip
+	case CollisionSkip, CollisionAbort:
+	default:
+		return fmt.Errorf("unknown on_collision: %s", pluginConfig.OnCollision)
+	}
+
+	var matchRegex *regexp.Regexp
+	if pluginConfig.Match != "" {
+		re, err := regexp.Compile(pluginConfig.Match)
+		if err != nil {
+			return fmt.Errorf("invalid match: %v", err)
+		}
+		matchRegex = re
+	}
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	targets, err := collectRenameTargets(workDirs, pluginConfig, filter, matchRegex)
+	if err != nil {
+		return err
+	}
+
+	// 전체 변경 목록을 만든 뒤 한 번에 충돌 검사
+	var pairs []utils.RenamePair
+	for _, target := range targets {
+		newName, err := expandRenameTemplate(pluginConfig.Template, target)
+		if err != nil {
+			log.Collisions = append(log.Collisions, fmt.Sprintf("%s: %v", target.path, err))
+			continue
+		}
+		pairs = append(pairs, utils.RenamePair{From: target.path, To: filepath.Join(filepath.Dir(target.path), newName)})
+	}
+
+	valid, conflicts := utils.PlanRenames(pairs)
+	log.Collisions = append(log.Collisions, conflicts...)
+
+	if len(log.Collisions) > 0 && pluginConfig.OnCollision == CollisionAbort {
+		log.Aborted = true
+	} else {
+		done, err := utils.ApplyRenames(valid)
+		for _, rename := range done {
+			log.RenamedFiles[rename.From] = rename.To
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	log.TotalFiles = len(log.RenamedFiles)
+
+	logFileName := fmt.Sprintf("rename_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeRenameLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	if log.Aborted {
+		return fmt.Errorf("rename aborted: %d collisions (see log)", len(log.Collisions))
+	}
+	return nil
+}
+
+// collectRenameTargets: 선택 조건에 맞는 파일을 폴더별 이름 순으로 모으고 순번을 매긴다.
+func collectRenameTargets(workDirs []string, pluginConfig RenameConfig, filter *fileMatcher, matchRegex *regexp.Regexp) ([]renameTarget, error) {
+	start := 1
+	if pluginConfig.SeqStart != nil {
+		start = *pluginConfig.SeqStart
+	}
+
+	// 폴더별 파일 목록 (폴더 순서 유지)
+	var dirs []string
+	filesByDir := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, dir := range workDirs {
+		err := walkFiles(dir, pluginConfig.SearchSubdirs, func(path string) error {
+			if seen[path] || !filter.match(path, nil) {
+				return nil
+			}
+			seen[path] = true
+
+			parent := filepath.Dir(path)
+			if _, ok := filesByDir[parent]; !ok {
+				dirs = append(dirs, parent)
+			}
+			filesByDir[parent] = append(filesByDir[parent], path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var targets []renameTarget
+	seq := start
+	for _, dir := range dirs {
+		if pluginConfig.SeqReset == SeqResetDirectory {
+			seq = start
+		}
+
+		files := filesByDir[dir]
+		slices.SortFunc(files, func(a, b string) int {
+			return strings.Compare(utils.NormalizeName(a), utils.NormalizeName(b))
+		})
+
+		for _, path := range files {
+			name := utils.NormalizeName(filepath.Base(path))
+
+			target := renameTarget{path: path, seq: seq}
+			switch {
+			case matchRegex != nil:
+				target.captures = matchRegex.FindStringSubmatch(name)
+				if target.captures == nil {
+					continue
+				}
+				target.names = matchRegex.SubexpNames()
+			case pluginConfig.Glob != "":
+				if matched, _ := filepath.Match(utils.NormalizeName(pluginConfig.Glob), name); !matched {
+					continue
+				}
+			}
+
+			targets = append(targets, target)
+			seq++
+		}
+	}
+
+	return targets, nil
+}
+
+// validateRenameTemplate: 템플릿의 필드 인자와 대소문자 변환을 확인한다.
+func validateRenameTemplate(template string) error {
+	for _, m := range renamePlaceholderRegex.FindAllStringSubmatch(template, -1) {
+		field, arg, caseName := m[1], m[2], m[3]
+		if field == "seq" && arg != "" {
+			if _, err := strconv.Atoi(arg); err != nil {
+				return fmt.Errorf("invalid seq padding in template: %s", m[0])
+			}
+		}
+		if caseName != "" {
+			if _, ok := renameCaseTransforms[caseName]; !ok {
+				return fmt.Errorf("unknown case transform in template: %s", m[0])
+			}
+		}
+	}
+	return nil
+}
+
+// expandRenameTemplate: 템플릿의 필드를 값으로 바꾼 새 파일명을 만든다.
+//
+//	{name} 확장자를 뺀 파일명, {ext} 확장자(. 포함), {parent} 상위 폴더 이름
+//	{seq} 순번, {seq:3} 3자리로 0 채움, {date} 수정 날짜 (기본 20060102, {date:2006-01} 형식 지정)
+//	{0} 일치한 전체, {1}... 캡처 그룹, {이름} 이름 있는 캡처 그룹
+//	|upper, |lower, |title, |snake, |kebab 대소문자 변환
+func expandRenameTemplate(template string, target renameTarget) (string, error) {
+	base := utils.NormalizeName(filepath.Base(target.path))
+	ext := filepath.Ext(base)
+
+	var expandErr error
+	result := renamePlaceholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		m := renamePlaceholderRegex.FindStringSubmatch(placeholder)
+		field, arg, caseName := m[1], m[2], m[3]
+
+		var value string
+		switch field {
+		case "name":
+			value = strings.TrimSuffix(base, ext)
+		case "ext":
+			value = ext
+		case "parent":
+			value = utils.NormalizeName(filepath.Base(filepath.Dir(target.path)))
+		case "seq":
+			width, _ := strconv.Atoi(arg)
+			value = fmt.Sprintf("%0*d", width, target.seq)
+		case "date":
+			info, err := os.Stat(target.path)
+			if err != nil {
+				expandErr = err
+				return placeholder
+			}
+			layout := arg
+			if layout == "" {
+				layout = defaultRenameDateLayout
+			}
+			value = info.ModTime().Format(layout)
+		default:
+			captured, ok := renameCapture(field, target)
+			if !ok {
+				expandErr = fmt.Errorf("unknown field in template: %s", placeholder)
+				return placeholder
+			}
+			value = captured
+		}
+
+		if caseName != "" {
+			value = renameCaseTransforms[caseName](value)
+		}
+		return value
+	})
+
+	if expandErr != nil {
+		return "", expandErr
+	}
+	if result == "" || result == "." || result == ".." || strings.ContainsAny(result, `/\`) {
+		return "", fmt.Errorf("invalid file name from template: %q", result)
+	}
+	return result, nil
+}
+
+// renameCapture: 번호({1}) 또는 이름({year})으로 캡처 그룹 값을 찾는다.
+func renameCapture(field string, target renameTarget) (string, bool) {
+	if index, err := strconv.Atoi(field); err == nil {
+		if index < 0 || index >= len(target.captures) {
+			return "", false
+		}
+		return target.captures[index], true
+	}
+
+	for i, name := range target.names {
+		if name != "" && name == field && i < len(target.captures) {
+			return target.captures[i], true
+		}
+	}
+	return "", false
+}
+
+var renameCaseTransforms = map[string]func(string) string{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"title": func(s string) string {
+		return joinWords(splitWords(s), " ", func(w string) string {
+			runes := []rune(strings.ToLower(w))
+			runes[0] = unicode.ToUpper(runes[0])
+			return string(runes)
+		})
+	},
+	"snake": func(s string) string {
+		return joinWords(splitWords(s), "_", strings.ToLower)
+	},
+	"kebab": func(s string) string {
+		return joinWords(splitWords(s), "-", strings.ToLower)
+	},
+}
+
+// splitWords: 공백, _, -, 소문자 뒤의 대문자(camelCase) 기준으로 단어를 나눈다.
+func splitWords(s string) []string {
+	var words []string
+	var current []rune
+	prev := rune(0)
+
+	for _, r := range s {
+		switch {
+		case r == ' ' || r == '_' || r == '-':
+			if len(current) > 0 {
+				words = append(words, string(current))
+				current = nil
+			}
+		case unicode.IsUpper(r) && unicode.IsLower(prev) && len(current) > 0:
+			words = append(words, string(current))
+			current = []rune{r}
+		default:
+			current = append(current, r)
+		}
+		prev = r
+	}
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+	return words
+}
+
+func joinWords(words []string, sep string, transform func(string) string) string {
+	for i, word := range words {
+		words[i] = transform(word)
+	}
+	return strings.Join(words, sep)
+}
+
+func writeRenameLogFile(log *RenameLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Rename Processing Log\n")
+	fmt.Fprintf(file, "Total files processed: %d\n", log.TotalFiles)
+	if log.Aborted {
+		fmt.Fprintf(file, "ABORTED: collisions found, no files were renamed\n")
+	}
+	fmt.Fprintf(file, "\n")
+
+	fmt.Fprintf(file, "=== RENAMED FILES ===\n")
+	for original, renamed := range log.RenamedFiles {
+		fmt.Fprintf(file, "RENAMED: %s -> %s\n", original, renamed)
+	}
+
+	fmt.Fprintf(file, "\n=== COLLISIONS ===\n")
+	for _, collision := range log.Collisions {
+		fmt.Fprintf(file, "COLLISION: %s\n", collision)
+	}
+
+	return nil
+}
+
+func (r *Rename) GetName() string {
+	return "RENAME"
+}
+
+func (r *Rename) GetDescription() string {
+	return "정규식 또는 glob으로 고른 파일의 이름을 템플릿으로 변경합니다. " +
+		"캡처 그룹, 순번, 상위 폴더 이름, 날짜, 대소문자 변환을 지원하고 변경 전에 전체 충돌을 확인합니다."
+}
@@ -0,0 +1,77 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestExpandRenameTemplate(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "week1")
+	os.MkdirAll(dir, 0755)
+	path := filepath.Join(dir, "hong_Report-final.PDF")
+	os.WriteFile(path, nil, 0644)
+	modTime := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+	os.Chtimes(path, modTime, modTime)
+
+	re := regexp.MustCompile(`^(?P<student>[a-z]+)_(.+)\.PDF$`)
+	target := renameTarget{path: path, captures: re.FindStringSubmatch("hong_Report-final.PDF"), names: re.SubexpNames(), seq: 7}
+
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{"{parent}_{seq:3}{ext|lower}", "week1_007.pdf"},
+		{"{student|upper}_{2|snake}{ext}", "HONG_report_final.PDF"},
+		{"{date}_{name}{ext}", "20240501_hong_Report-final.PDF"},
+		{"{date:2006-01}_{1|title}{ext}", "2024-05_Hong.PDF"},
+		{"{2|kebab}{ext}", "report-final.PDF"},
+	}
+
+	for _, tc := range tests {
+		got, err := expandRenameTemplate(tc.template, target)
+		if err != nil || got != tc.expected {
+			t.Errorf("template: %q, expected: %q, got: %q (%v)", tc.template, tc.expected, got, err)
+		}
+	}
+
+	for _, template := range []string{"{3}", "{unknown}", "{parent}/{name}"} {
+		if _, err := expandRenameTemplate(template, target); err == nil {
+			t.Errorf("template %q: expected error", template)
+		}
+	}
+}
+
+func TestCollectRenameTargetsSeqReset(t *testing.T) {
+	base := t.TempDir()
+	for _, path := range []string{"a/x.txt", "a/y.txt", "b/z.txt", "b/skip.pdf"} {
+		os.MkdirAll(filepath.Join(base, filepath.Dir(path)), 0755)
+		os.WriteFile(filepath.Join(base, filepath.FromSlash(path)), nil, 0644)
+	}
+	workDirs := []string{filepath.Join(base, "a"), filepath.Join(base, "b")}
+
+	for _, tc := range []struct {
+		reset    string
+		expected []int
+	}{
+		{SeqResetDirectory, []int{1, 2, 1}},
+		{SeqResetNone, []int{1, 2, 3}},
+	} {
+		pluginConfig := RenameConfig{Glob: "*.txt", SeqReset: tc.reset}
+		filter, _ := pluginConfig.FileFilter.compile(time.Now())
+		targets, err := collectRenameTargets(workDirs, pluginConfig, filter, nil)
+		if err != nil {
+			t.Fatalf("collectRenameTargets failed: %v", err)
+		}
+		if len(targets) != len(tc.expected) {
+			t.Fatalf("%s: expected %d targets, got %d", tc.reset, len(tc.expected), len(targets))
+		}
+		for i, target := range targets {
+			if target.seq != tc.expected[i] {
+				t.Errorf("%s: %s expected seq %d, got %d", tc.reset, target.path, tc.expected[i], target.seq)
+			}
+		}
+	}
+}
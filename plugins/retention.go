@@ -0,0 +1,251 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yek-j/filemanager/config"
+)
+
+type Retention struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 보관 기간이 지난 파일 처리 방식
+const (
+	RetentionDelete  = "delete"  // 삭제 (기본값)
+	RetentionArchive = "archive" // archive_location 폴더로 이동
+)
+
+const defaultRetentionMinKeep = 1
+
+// Retention 플러그인의 설정값 구조체
+type RetentionConfig struct {
+	// 보관 기준 - 둘 다 지정하면 둘 중 하나라도 해당하는 파일을 처리
+	MaxAgeDays int `json:"max_age_days,omitempty"` // 수정 시간이 지정한 일 수보다 오래된 파일
+	KeepNewest int `json:"keep_newest,omitempty"`  // 폴더별 최신 N개만 남김
+
+	// 폴더마다 최소로 남길 최신 파일 수 (기본 1, 0이면 보호하지 않음)
+	MinKeep *int `json:"min_keep,omitempty"`
+
+	Action          string `json:"action,omitempty"`           // delete, archive
+	ArchiveLocation string `json:"archive_location,omitempty"` // archive 시 이동할 폴더 (depth 폴더 기준)
+
+	// 대상 파일 선택 조건
+	FileFilter
+
+	// 정리할 타겟 폴더와 깊이
+	TargetScope
+}
+
+type RetentionLog struct {
+	ExpiredFiles map[string]string // 원본경로 -> 이동경로 (삭제면 "")
+	GuardedFiles []string          // min_keep 때문에 남긴 파일
+	FailedFiles  []string          // 실패한 파일 (전체 경로)
+	Action       string
+	TotalFiles   int
+}
+
+func (r *Retention) Process(cfg *config.Config) error {
+	totalProcessed := 0
+
+	// 설정 구조체
+	var pluginConfig RetentionConfig
+
+	// Config 파싱
+	if r.pluginCfg != nil && len(r.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(r.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	if pluginConfig.MaxAgeDays <= 0 && pluginConfig.KeepNewest <= 0 {
+		return fmt.Errorf("max_age_days or keep_newest is required")
+	}
+
+	switch pluginConfig.Action {
+	case "":
+		pluginConfig.Action = RetentionDelete
+	case RetentionDelete:
+	case RetentionArchive:
+		if pluginConfig.ArchiveLocation == "" {
+			return fmt.Errorf("archive_location is required for action: archive")
+		}
+	default:
+		return fmt.Errorf("unknown retention action: %s", pluginConfig.Action)
+	}
+
+	now := time.Now()
+	filter, err := pluginConfig.FileFilter.compile(now)
+	if err != nil {
+		return err
+	}
+
+	log := &RetentionLog{
+		ExpiredFiles: make(map[string]string),
+		Action:       pluginConfig.Action,
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range workDirs {
+		count, err := applyRetention(dir, pluginConfig, filter, now, log)
+		totalProcessed += count
+		if err != nil {
+			return err
+		}
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("retention_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeRetentionLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// applyRetention: workDir 바로 아래의 대상 파일 중 보관 기준을 넘은 파일을 처리한다.
+func applyRetention(workDir string, pluginConfig RetentionConfig, filter *fileMatcher, now time.Time, log *RetentionLog) (int, error) {
+	processFileCount := 0
+
+	type retentionFile struct {
+		path    string
+		modTime time.Time
+	}
+
+	entries, err := os.ReadDir(workDir)
+	if err != nil {
+		return processFileCount, err
+	}
+
+	var files []retentionFile
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if !filter.match(filepath.Join(workDir, entry.Name()), entry) {
+			continue // 건너뛰기
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return processFileCount, err
+		}
+		files = append(files, retentionFile{path: filepath.Join(workDir, entry.Name()), modTime: info.ModTime()})
+	}
+
+	// 최신 파일이 앞에 오도록 정렬 (수정 시간이 같으면 경로 순)
+	slices.SortFunc(files, func(a, b retentionFile) int {
+		if c := b.modTime.Compare(a.modTime); c != 0 {
+			return c
+		}
+		return strings.Compare(a.path, b.path)
+	})
+
+	minKeep := defaultRetentionMinKeep
+	if pluginConfig.MinKeep != nil {
+		minKeep = max(*pluginConfig.MinKeep, 0)
+	}
+
+	cutoff := now.AddDate(0, 0, -pluginConfig.MaxAgeDays)
+	for i, file := range files {
+		expired := (pluginConfig.KeepNewest > 0 && i >= pluginConfig.KeepNewest) ||
+			(pluginConfig.MaxAgeDays > 0 && file.modTime.Before(cutoff))
+		if !expired {
+			continue
+		}
+
+		// 최신 파일부터 min_keep개는 항상 남김
+		if i < minKeep {
+			log.GuardedFiles = append(log.GuardedFiles, file.path)
+			continue
+		}
+
+		targetPath, err := expireFile(file.path, workDir, pluginConfig)
+		if err != nil {
+			log.FailedFiles = append(log.FailedFiles, file.path)
+			continue
+		}
+
+		log.ExpiredFiles[file.path] = targetPath
+		processFileCount++
+	}
+
+	return processFileCount, nil
+}
+
+// expireFile: action에 따라 파일을 삭제하거나 archive_location으로 이동한다.
+func expireFile(path string, workDir string, pluginConfig RetentionConfig) (string, error) {
+	if pluginConfig.Action == RetentionArchive {
+		archiveDir := filepath.Join(workDir, pluginConfig.ArchiveLocation)
+		targetPath, moved, err := moveFile(path, archiveDir, true, false)
+		if err != nil {
+			return "", err
+		}
+		if !moved {
+			return "", fmt.Errorf("archive target already exists: %s", targetPath)
+		}
+		return targetPath, nil
+	}
+
+	return "", os.Remove(path)
+}
+
+func writeRetentionLogFile(log *RetentionLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Retention Processing Log\n")
+	fmt.Fprintf(file, "Action: %s\n", log.Action)
+	fmt.Fprintf(file, "Total files processed: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== EXPIRED FILES ===\n")
+	for original, archived := range log.ExpiredFiles {
+		if archived == "" {
+			fmt.Fprintf(file, "DELETED: %s\n", original)
+		} else {
+			fmt.Fprintf(file, "ARCHIVED: %s -> %s\n", original, archived)
+		}
+	}
+
+	fmt.Fprintf(file, "\n=== KEPT BY MIN_KEEP ===\n")
+	for _, guarded := range log.GuardedFiles {
+		fmt.Fprintf(file, "KEPT: %s\n", guarded)
+	}
+
+	fmt.Fprintf(file, "\n=== FAILED FILES ===\n")
+	for _, failed := range log.FailedFiles {
+		fmt.Fprintf(file, "FAILED: %s\n", failed)
+	}
+
+	return nil
+}
+
+func (r *Retention) GetName() string {
+	return "RETENTION"
+}
+
+func (r *Retention) GetDescription() string {
+	return "보관 기간이 지난 파일 또는 폴더별 최신 N개를 넘는 파일을 삭제하거나 보관 폴더로 이동합니다. " +
+		"min_keep 개수만큼은 항상 남깁니다."
+}
@@ -0,0 +1,77 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestApplyRetention(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		pluginConfig RetentionConfig
+		remaining    []string
+	}{
+		{
+			name:         "max age",
+			pluginConfig: RetentionConfig{MaxAgeDays: 30},
+			remaining:    []string{"a.log", "b.log"},
+		},
+		{
+			name:         "keep newest",
+			pluginConfig: RetentionConfig{KeepNewest: 1},
+			remaining:    []string{"a.log"},
+		},
+		{
+			// 모든 파일이 기간을 넘어도 min_keep개는 남김
+			name:         "min keep guard",
+			pluginConfig: RetentionConfig{MaxAgeDays: 1},
+			remaining:    []string{"a.log"},
+		},
+		{
+			name:         "name pattern",
+			pluginConfig: RetentionConfig{KeepNewest: 1, FileFilter: FileFilter{NamePattern: `^[bc]`}},
+			remaining:    []string{"a.log", "b.log"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			// a: 5일 전, b: 10일 전, c: 60일 전
+			for name, days := range map[string]int{"a.log": 5, "b.log": 10, "c.log": 60} {
+				path := filepath.Join(dir, name)
+				os.WriteFile(path, nil, 0644)
+				modTime := now.AddDate(0, 0, -days)
+				os.Chtimes(path, modTime, modTime)
+			}
+
+			log := &RetentionLog{ExpiredFiles: make(map[string]string)}
+			tc.pluginConfig.Action = RetentionDelete
+			filter, err := tc.pluginConfig.FileFilter.compile(now)
+			if err != nil {
+				t.Fatalf("compile failed: %v", err)
+			}
+			if _, err := applyRetention(dir, tc.pluginConfig, filter, now, log); err != nil {
+				t.Fatalf("applyRetention failed: %v", err)
+			}
+
+			entries, _ := os.ReadDir(dir)
+			var remaining []string
+			for _, entry := range entries {
+				remaining = append(remaining, entry.Name())
+			}
+			if len(remaining) != len(tc.remaining) {
+				t.Fatalf("expected %v, got %v", tc.remaining, remaining)
+			}
+			for i := range remaining {
+				if remaining[i] != tc.remaining[i] {
+					t.Errorf("expected %v, got %v", tc.remaining, remaining)
+				}
+			}
+		})
+	}
+}
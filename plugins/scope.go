@@ -0,0 +1,77 @@
+package plugins
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+// TargetScope 플러그인이 작업할 폴더 범위 - 모든 플러그인 설정에 포함된다
+type TargetScope struct {
+	TargetFolders []string `json:"target_folders"`      // 작업할 타겟 폴더 (glob/정규식 가능)
+	Depth         int      `json:"depth,omitempty"`     // 플러그인별 깊이 (없으면 file_depth)
+	MinDepth      int      `json:"min_depth,omitempty"` // 깊이 범위 시작
+	MaxDepth      int      `json:"max_depth,omitempty"` // 깊이 범위 끝
+	LeafDirs      bool     `json:"leaf_dirs,omitempty"` // 하위 폴더가 없는 모든 폴더
+}
+
+// targetFolderPaths: target_folders 패턴을 work_path 기준으로 확장한 전체 경로 목록
+func (s *TargetScope) targetFolderPaths(cfg *config.Config) ([]string, error) {
+	targetFolders, _, err := utils.ExpandTargetFolders(cfg.WorkPath, s.TargetFolders)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(targetFolders))
+	for _, targetFolder := range targetFolders {
+		paths = append(paths, filepath.Join(cfg.WorkPath, targetFolder))
+	}
+	return paths, nil
+}
+
+// dirsIn: basePath(타겟 폴더) 안에서 작업할 폴더 목록
+func (s *TargetScope) dirsIn(basePath string, cfg *config.Config) ([]string, error) {
+	depth := s.Depth
+	if depth == 0 {
+		depth = cfg.TargetDepth
+	}
+
+	switch {
+	case s.LeafDirs:
+		return utils.GetLeafDirs(basePath), nil
+	case s.MinDepth > 0 || s.MaxDepth > 0:
+		minDepth, maxDepth := s.MinDepth, s.MaxDepth
+		if minDepth == 0 {
+			minDepth = 1
+		}
+		if maxDepth == 0 {
+			maxDepth = max(minDepth, depth)
+		}
+		if minDepth > maxDepth {
+			return nil, fmt.Errorf("min_depth (%d) is greater than max_depth (%d)", minDepth, maxDepth)
+		}
+		return utils.GetTargetDirsInRange(basePath, minDepth, maxDepth), nil
+	default:
+		return utils.GetTargetDirs(basePath, depth), nil
+	}
+}
+
+// workDirs: 모든 타겟 폴더에서 작업할 폴더 목록
+func (s *TargetScope) workDirs(cfg *config.Config) ([]string, error) {
+	basePaths, err := s.targetFolderPaths(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var workDirs []string
+	for _, basePath := range basePaths {
+		dirs, err := s.dirsIn(basePath, cfg)
+		if err != nil {
+			return nil, err
+		}
+		workDirs = append(workDirs, dirs...)
+	}
+	return workDirs, nil
+}
@@ -0,0 +1,241 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/yek-j/filemanager/config"
+	"github.com/yek-j/filemanager/utils"
+)
+
+type Split struct {
+	pluginCfg *config.PluginConfig
+}
+
+// 나누는 기준
+const (
+	SplitByCount     = "count"     // bucket_size개씩 001, 002... (기본값)
+	SplitByLetter    = "letter"    // 첫 글자 (A, B..., 한글은 초성 ㄱ, ㄴ..., 숫자 0-9, 나머지 #)
+	SplitByExtension = "extension" // 확장자 (pdf, jpg..., 없으면 no_ext)
+)
+
+const defaultSplitBucketSize = 1000
+
+// 한글 음절의 초성 (U+AC00부터 588자마다)
+var hangulInitials = []rune("ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ")
+
+// Split 플러그인의 설정값 구조체
+type SplitConfig struct {
+	By           string `json:"by,omitempty"`            // count, letter, extension
+	BucketSize   int    `json:"bucket_size,omitempty"`   // by: count일 때 폴더당 파일 수 (기본 1000)
+	BucketPrefix string `json:"bucket_prefix,omitempty"` // 폴더 이름 앞에 붙일 문자
+	MinFiles     int    `json:"min_files,omitempty"`     // 파일이 이 수보다 많을 때만 나눔
+
+	// 나눌 파일 선택 조건 - 조건에 맞지 않는 파일은 그대로 둔다
+	FileFilter
+
+	// 나눌 타겟 폴더와 깊이 - depth 폴더 바로 아래의 파일을 하위 폴더로 이동
+	TargetScope
+}
+
+type SplitLog struct {
+	MovedFiles map[string]string // 원본경로 -> 대상경로
+	Collisions []string          // 이동하지 않은 항목
+	TotalFiles int
+}
+
+func (s *Split) Process(cfg *config.Config) error {
+	totalProcessed := 0
+	log := &SplitLog{
+		MovedFiles: make(map[string]string),
+	}
+
+	// 설정 구조체
+	var pluginConfig SplitConfig
+
+	// Config 파싱
+	if s.pluginCfg != nil && len(s.pluginCfg.Config) > 0 {
+		err := json.Unmarshal(s.pluginCfg.Config, &pluginConfig)
+		if err != nil {
+			return fmt.Errorf("failed to parse plugin config: %v", err)
+		}
+	}
+
+	switch pluginConfig.By {
+	case "":
+		pluginConfig.By = SplitByCount
+	case SplitByCount, SplitByLetter, SplitByExtension:
+	default:
+		return fmt.Errorf("unknown split by: %s", pluginConfig.By)
+	}
+	if pluginConfig.BucketSize <= 0 {
+		pluginConfig.BucketSize = defaultSplitBucketSize
+	}
+	if strings.ContainsAny(pluginConfig.BucketPrefix, `/\`) {
+		return fmt.Errorf("bucket_prefix must not contain path separators: %q", pluginConfig.BucketPrefix)
+	}
+
+	filter, err := pluginConfig.FileFilter.compile(time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 작업할 경로 (target_folders + depth 설정)
+	workDirs, err := pluginConfig.workDirs(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range workDirs {
+		count, err := splitDir(dir, pluginConfig, filter, log)
+		totalProcessed += count
+		if err != nil {
+			return err
+		}
+	}
+
+	log.TotalFiles = totalProcessed
+
+	logFileName := fmt.Sprintf("split_log_%s.txt",
+		time.Now().Format("20060102_150405"))
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
+
+	if err := writeSplitLogFile(log, logPath); err != nil {
+		fmt.Printf("Warning: Failed to write log file: %v\n", err)
+	} else {
+		fmt.Printf("📝 Log file created: %s\n", logPath)
+	}
+
+	return nil
+}
+
+// splitDir: workDir 바로 아래의 파일을 기준에 따라 하위 폴더로 나눈다.
+func splitDir(workDir string, pluginConfig SplitConfig, filter *fileMatcher, log *SplitLog) (int, error) {
+	entries, err := os.ReadDir(workDir)
+	if err != nil {
+		return 0, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filter.match(filepath.Join(workDir, entry.Name()), entry) {
+			names = append(names, entry.Name())
+		}
+	}
+	if len(names) == 0 || len(names) <= pluginConfig.MinFiles {
+		return 0, nil
+	}
+
+	slices.SortFunc(names, func(a, b string) int {
+		return strings.Compare(utils.NormalizeName(a), utils.NormalizeName(b))
+	})
+
+	// count 기준 폴더 이름 자리수 (최소 3자리)
+	width := 3
+	if pluginConfig.By == SplitByCount {
+		width = max(width, len(strconv.Itoa((len(names)+pluginConfig.BucketSize-1)/pluginConfig.BucketSize)))
+	}
+
+	var pairs []utils.RenamePair
+	for i, name := range names {
+		var bucket string
+		switch pluginConfig.By {
+		case SplitByCount:
+			bucket = fmt.Sprintf("%0*d", width, i/pluginConfig.BucketSize+1)
+		case SplitByLetter:
+			bucket = letterBucket(name)
+		case SplitByExtension:
+			bucket = strings.TrimPrefix(utils.NormalizeExt(filepath.Ext(name)), ".")
+			if bucket == "" {
+				bucket = "no_ext"
+			}
+		}
+
+		bucketDir := filepath.Join(workDir, pluginConfig.BucketPrefix+bucket)
+		pairs = append(pairs, utils.RenamePair{From: filepath.Join(workDir, name), To: filepath.Join(bucketDir, name)})
+	}
+
+	// 폴더 이름이 기존 파일과 같으면 그 폴더로 가는 파일은 충돌
+	var valid []utils.RenamePair
+	for _, pair := range pairs {
+		bucketDir := filepath.Dir(pair.To)
+		if info, err := os.Stat(bucketDir); err == nil && !info.IsDir() {
+			log.Collisions = append(log.Collisions, fmt.Sprintf("%s -> %s: bucket is not a directory", pair.From, pair.To))
+			continue
+		}
+		if err := os.MkdirAll(bucketDir, 0755); err != nil {
+			return 0, err
+		}
+		valid = append(valid, pair)
+	}
+
+	valid, conflicts := utils.PlanRenames(valid)
+	log.Collisions = append(log.Collisions, conflicts...)
+
+	done, err := utils.ApplyRenames(valid)
+	for _, rename := range done {
+		log.MovedFiles[rename.From] = rename.To
+	}
+	if err != nil {
+		return len(done), err
+	}
+
+	return len(valid), nil
+}
+
+// letterBucket: 파일명 첫 글자로 폴더 이름을 정한다.
+func letterBucket(name string) string {
+	for _, r := range utils.NormalizeName(name) {
+		switch {
+		case r >= '0' && r <= '9':
+			return "0-9"
+		case r < unicode.MaxASCII && unicode.IsLetter(r):
+			return string(unicode.ToUpper(r))
+		case r >= 0xAC00 && r <= 0xD7A3:
+			return string(hangulInitials[(r-0xAC00)/588])
+		case unicode.IsLetter(r):
+			return string(unicode.ToUpper(r))
+		default:
+			return "#"
+		}
+	}
+	return "#"
+}
+
+func writeSplitLogFile(log *SplitLog, logPath string) error {
+	file, err := os.Create(logPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "FileManager Split Processing Log\n")
+	fmt.Fprintf(file, "Total files processed: %d\n\n", log.TotalFiles)
+
+	fmt.Fprintf(file, "=== MOVED FILES ===\n")
+	for original, moved := range log.MovedFiles {
+		fmt.Fprintf(file, "MOVED: %s -> %s\n", original, moved)
+	}
+
+	fmt.Fprintf(file, "\n=== COLLISIONS ===\n")
+	for _, collision := range log.Collisions {
+		fmt.Fprintf(file, "COLLISION: %s\n", collision)
+	}
+
+	return nil
+}
+
+func (s *Split) GetName() string {
+	return "SPLIT"
+}
+
+func (s *Split) GetDescription() string {
+	return "파일이 많은 폴더를 개수, 첫 글자 또는 확장자 기준의 하위 폴더로 나눕니다."
+}
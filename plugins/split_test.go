@@ -0,0 +1,52 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLetterBucket(t *testing.T) {
+	tests := map[string]string{
+		"apple.txt":  "A",
+		"Zoo.txt":    "Z",
+		"2024.txt":   "0-9",
+		"과제.pdf":     "ㄱ",
+		"한글.hwp":     "ㅎ",
+		"_draft.txt": "#",
+	}
+	for input, expected := range tests {
+		if got := letterBucket(input); got != expected {
+			t.Errorf("input: %q, expected: %q, got: %q", input, expected, got)
+		}
+	}
+}
+
+func TestSplitDir(t *testing.T) {
+	for _, tc := range []struct {
+		pluginConfig SplitConfig
+		expected     []string
+	}{
+		{SplitConfig{By: SplitByCount, BucketSize: 2}, []string{"001/a.pdf", "001/b.txt", "002/c"}},
+		{SplitConfig{By: SplitByExtension, BucketPrefix: "by_"}, []string{"by_pdf/a.pdf", "by_txt/b.txt", "by_no_ext/c"}},
+		{SplitConfig{By: SplitByCount, BucketSize: 2, MinFiles: 3}, []string{"a.pdf", "b.txt", "c"}},
+	} {
+		dir := t.TempDir()
+		for _, name := range []string{"a.pdf", "b.txt", "c"} {
+			os.WriteFile(filepath.Join(dir, name), nil, 0644)
+		}
+
+		log := &SplitLog{MovedFiles: make(map[string]string)}
+		filter, _ := tc.pluginConfig.FileFilter.compile(time.Now())
+		if _, err := splitDir(dir, tc.pluginConfig, filter, log); err != nil {
+			t.Fatalf("splitDir failed: %v", err)
+		}
+
+		for _, path := range tc.expected {
+			if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(path))); err != nil {
+				t.Errorf("%+v: expected %s: %v", tc.pluginConfig, path, err)
+			}
+		}
+	}
+}
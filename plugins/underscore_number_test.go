@@ -1,7 +1,13 @@
 package plugins
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 type parseFileNameTest struct {
@@ -64,3 +70,223 @@ func TestParseFileName(t *testing.T) {
 		}
 	}
 }
+
+func TestParseNameWithPatterns(t *testing.T) {
+	patterns, err := compileNamePatterns([]NamePattern{
+		{Name: "dash_v"},
+		{Name: "paren"},
+		{Name: "date"},
+		{Name: "semver"},
+		{Name: "padded"},
+	})
+	if err != nil {
+		t.Fatalf("compileNamePatterns failed: %v", err)
+	}
+
+	tests := []struct {
+		input           string
+		expectedPrefix  string
+		expectedPattern string
+		expectedValid   bool
+	}{
+		{"report-v3.pdf", "report", "dash_v", true},
+		{"report (2).pdf", "report", "paren", true},
+		{"report_003.pdf", "report", "padded", true},
+		{"report_2024-05-01.pdf", "report", "date", true},
+		{"report_v1.2.0.pdf", "report", "semver", true},
+		{"report.pdf", "", "", false},
+		{"report_2024-13-01.pdf", "", "", false}, // 날짜 아님, 숫자도 아님
+	}
+
+	for _, tc := range tests {
+		parsed, valid, err := parseName(tc.input, patterns)
+		if err != nil {
+			t.Fatalf("input: %q, unexpected error: %v", tc.input, err)
+		}
+
+		if valid != tc.expectedValid || parsed.Prefix != tc.expectedPrefix || parsed.Pattern != tc.expectedPattern {
+			t.Errorf("input: %q, expected (%q, %q, %t), got (%q, %q, %t)",
+				tc.input, tc.expectedPrefix, tc.expectedPattern, tc.expectedValid,
+				parsed.Prefix, parsed.Pattern, valid)
+		}
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	semver, _ := compileNamePatterns([]NamePattern{{Name: "semver"}})
+	date, _ := compileNamePatterns([]NamePattern{{Name: "date"}})
+
+	tests := []struct {
+		a, b     string
+		patterns []*namePattern
+		expected int
+	}{
+		{"r_9.pdf", "r_10.pdf", []*namePattern{defaultNamePattern}, -1},
+		{"r_010.pdf", "r_10.pdf", []*namePattern{defaultNamePattern}, 0},
+		{"r_1.10.pdf", "r_1.9.pdf", semver, 1},
+		{"r_1.2.pdf", "r_1.2.0.pdf", semver, 0},
+		{"r_2024-05-01.pdf", "r_2023-12-31.pdf", date, 1},
+	}
+
+	for _, tc := range tests {
+		a, _, _ := parseName(tc.a, tc.patterns)
+		b, _, _ := parseName(tc.b, tc.patterns)
+
+		if got := a.Version.Compare(b.Version); got != tc.expected {
+			t.Errorf("compare %q %q: expected %d, got %d", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
+
+func TestProcessDirKeepCountAndLatestBy(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string, modTime time.Time) {
+		path := filepath.Join(dir, name)
+		os.WriteFile(path, []byte(content), 0644)
+		os.Chtimes(path, modTime, modTime)
+	}
+
+	now := time.Now()
+	write("quiz_1.pdf", "a", now.Add(-time.Hour)) // 가장 최근 수정
+	write("quiz_2.pdf", "bb", now.Add(-3*time.Hour))
+	write("quiz_3.pdf", "ccc", now.Add(-2*time.Hour))
+	write("exam_1.pdf", "x", now)
+	write("exam_2.pdf", "x", now)
+	write("exam_99999999999999999999.pdf", "x", now) // 숫자 범위 초과 - exam 그룹 전체를 건너뜀
+
+	pluginConfig := UnderscoreNumberConfig{KeepCount: 2, LatestBy: LatestByModTime}
+	log := &UnderscoreNumberLog{RenamedFiles: make(map[string]string)}
+
+	filter, _ := pluginConfig.fileFilter(time.Now())
+	if _, err := processDir(dir, pluginConfig, []*namePattern{defaultNamePattern}, filter, log); err != nil {
+		t.Fatalf("processDir failed: %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	expected := "exam_1.pdf,exam_2.pdf,exam_99999999999999999999.pdf,quiz_1.pdf,quiz_3.pdf"
+	if strings.Join(names, ",") != expected {
+		t.Errorf("expected files %s, got %v", expected, names)
+	}
+
+	if len(log.SkippedFiles) != 3 {
+		t.Errorf("expected overflow file and its group to be logged as skipped, got %v", log.SkippedFiles)
+	}
+}
+
+func TestProcessDirIdenticalContent(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "quiz_1.pdf"), []byte("same"), 0644)
+	os.WriteFile(filepath.Join(dir, "quiz_2.pdf"), []byte("same"), 0644)
+
+	pluginConfig := UnderscoreNumberConfig{KeepCount: 1, LatestBy: LatestByContent}
+	log := &UnderscoreNumberLog{RenamedFiles: make(map[string]string)}
+
+	filter, _ := pluginConfig.fileFilter(time.Now())
+	if _, err := processDir(dir, pluginConfig, []*namePattern{defaultNamePattern}, filter, log); err != nil {
+		t.Fatalf("processDir failed: %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 2 || len(log.KeptGroups) != 1 {
+		t.Errorf("expected identical files to be kept, got %d files, log %v", len(entries), log.KeptGroups)
+	}
+}
+
+func TestSurvivorName(t *testing.T) {
+	file := FileInfo{Prefix: "quiz", Ext: ".pdf", Version: Version{Raw: "7"}}
+
+	tests := []struct {
+		config   UnderscoreNumberConfig
+		expected string
+	}{
+		{UnderscoreNumberConfig{Rename: RenameNone}, ""},
+		{UnderscoreNumberConfig{Rename: RenameReset}, "quiz_1.pdf"},
+		{UnderscoreNumberConfig{Rename: RenameStrip}, "quiz.pdf"},
+		{UnderscoreNumberConfig{Rename: RenamePadded, PadWidth: 3}, "quiz_001.pdf"},
+		{UnderscoreNumberConfig{Rename: RenameTemplate, RenameTemplate: "{prefix}_final_v{version}{ext}"}, "quiz_final_v7.pdf"},
+	}
+
+	for _, tc := range tests {
+		if got := survivorName(file, 1, true, tc.config); got != tc.expected {
+			t.Errorf("rename %s: expected %q, got %q", tc.config.Rename, tc.expected, got)
+		}
+	}
+}
+
+func TestProcessDirRenameCollision(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "quiz_2.pdf"), []byte("new"), 0644)
+	os.WriteFile(filepath.Join(dir, "quiz.pdf"), []byte("other"), 0644) // strip 대상과 겹침
+
+	pluginConfig := UnderscoreNumberConfig{KeepCount: 1, LatestBy: LatestByNumber, Rename: RenameStrip}
+	log := &UnderscoreNumberLog{RenamedFiles: make(map[string]string)}
+
+	filter, _ := pluginConfig.fileFilter(time.Now())
+	if _, err := processDir(dir, pluginConfig, []*namePattern{defaultNamePattern}, filter, log); err != nil {
+		t.Fatalf("processDir failed: %v", err)
+	}
+
+	if data, _ := os.ReadFile(filepath.Join(dir, "quiz.pdf")); string(data) != "other" {
+		t.Errorf("existing file was overwritten: %q", data)
+	}
+	if len(log.Collisions) != 1 || len(log.RenamedFiles) != 0 {
+		t.Errorf("expected 1 collision and no rename, got %v, %v", log.Collisions, log.RenamedFiles)
+	}
+}
+
+func TestProcessDirSubtreeScope(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "drafts"), 0755)
+	os.WriteFile(filepath.Join(dir, "drafts", "quiz_7.pdf"), []byte("latest"), 0644)
+	os.WriteFile(filepath.Join(dir, "quiz_3.pdf"), []byte("old"), 0644)
+
+	pluginConfig := UnderscoreNumberConfig{
+		KeepCount:        1,
+		LatestBy:         LatestByNumber,
+		Rename:           RenameReset,
+		GroupScope:       GroupScopeSubtree,
+		SurvivorLocation: ".",
+	}
+	log := &UnderscoreNumberLog{RenamedFiles: make(map[string]string)}
+
+	filter, _ := pluginConfig.fileFilter(time.Now())
+	if _, err := processDir(dir, pluginConfig, []*namePattern{defaultNamePattern}, filter, log); err != nil {
+		t.Fatalf("processDir failed: %v", err)
+	}
+
+	if data, _ := os.ReadFile(filepath.Join(dir, "quiz_1.pdf")); string(data) != "latest" {
+		t.Errorf("expected latest file moved to scope root as quiz_1.pdf, got %q", data)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "quiz_3.pdf")); !os.IsNotExist(err) {
+		t.Errorf("expected quiz_3.pdf to be deleted")
+	}
+}
+
+func TestProcessDirUnicodeAndCase(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, norm.NFD.String("퀴즈_1.pdf")), []byte("mac"), 0644)
+	os.WriteFile(filepath.Join(dir, "퀴즈_2.PDF"), []byte("windows"), 0644)
+
+	pluginConfig := UnderscoreNumberConfig{
+		AllowedExtensions: []string{"pdf"},
+		KeepCount:         1,
+		LatestBy:          LatestByNumber,
+		Rename:            RenameNone,
+	}
+	log := &UnderscoreNumberLog{RenamedFiles: make(map[string]string)}
+
+	filter, _ := pluginConfig.fileFilter(time.Now())
+	if _, err := processDir(dir, pluginConfig, []*namePattern{defaultNamePattern}, filter, log); err != nil {
+		t.Fatalf("processDir failed: %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 || entries[0].Name() != "퀴즈_2.PDF" {
+		t.Errorf("expected only 퀴즈_2.PDF to remain, got %v", entries)
+	}
+}
@@ -1,10 +1,13 @@
 package plugins
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -19,19 +22,80 @@ type UnderscoreNumber struct {
 
 type FileInfo struct {
 	FileName string
-	Number   int
+	Dir      string // 파일이 있는 폴더
+	Prefix   string
+	Version  Version
+	Ext      string
 	FullPath string
+	ModTime  time.Time
+	Size     int64
 }
 
 type UnderscoreNumberLog struct {
 	DeletedFiles []string          // 삭제된 파일 리스트
 	RenamedFiles map[string]string // 원본->새이름
+	SkippedFiles []string          // 버전을 읽을 수 없어 건너뛴 파일 (경로: 이유)
+	Ties         []string          // 기준 값이 같아 순서를 정한 그룹
+	KeptGroups   []string          // 모든 파일 내용이 같아 삭제하지 않은 그룹
+	Collisions   []string          // 대상 이름이 겹쳐 변경하지 않은 파일
 	TotalFiles   int
 }
 
+// latest_by 기준
+const (
+	LatestByNumber  = "number"  // 파일명의 버전 (기본값)
+	LatestByModTime = "mtime"   // 수정 시간
+	LatestBySize    = "size"    // 파일 크기
+	LatestByContent = "content" // 버전 기준, 모든 파일 내용이 같으면 삭제하지 않음
+)
+
+// rename 정책
+const (
+	RenameNone     = "none"     // 이름 변경 안 함
+	RenameReset    = "reset"    // prefix_1.ext (기본값)
+	RenameStrip    = "strip"    // prefix.ext
+	RenameTemplate = "template" // rename_template 사용
+	RenamePadded   = "padded"   // prefix_001.ext
+)
+
+const defaultPadWidth = 3
+
+// group_scope 값
+const (
+	GroupScopeDirectory    = "directory"     // 폴더마다 (기본값, 하위 폴더 제외)
+	GroupScopeSubtree      = "subtree"       // depth 폴더와 그 하위 폴더 전체
+	GroupScopeTargetFolder = "target_folder" // 타겟 폴더 전체
+)
+
 type UnderscoreNumberConfig struct {
-	AllowedExtensions []string `json:"allowed_extensions"`
-	TargetFolders     []string `json:"target_folders"`
+	// Deprecated: file_extensions를 사용. file_extensions가 없을 때만 적용한다.
+	AllowedExtensions []string `json:"allowed_extensions,omitempty"`
+
+	Patterns  []NamePattern `json:"patterns,omitempty"`   // 파일명 패턴 (없으면 prefix_숫자)
+	KeepCount int           `json:"keep_count,omitempty"` // 남길 파일 수 (기본 1)
+	LatestBy  string        `json:"latest_by,omitempty"`  // number, mtime, size, content
+
+	// 남은 파일 이름 변경
+	Rename         string `json:"rename,omitempty"`          // none, reset, strip, template, padded
+	RenameTemplate string `json:"rename_template,omitempty"` // {prefix}, {version}, {n}, {ext}
+	PadWidth       int    `json:"pad_width,omitempty"`       // padded 자릿수 (기본 3)
+
+	// 그룹 범위
+	GroupScope       string `json:"group_scope,omitempty"`       // directory, subtree, target_folder
+	SurvivorLocation string `json:"survivor_location,omitempty"` // 남은 파일을 옮길 위치 (그룹 범위 기준 상대 경로, 없으면 제자리)
+
+	// 정리할 파일 선택 조건
+	FileFilter
+	TargetScope
+}
+
+// fileFilter: 파일 선택 조건을 준비한다. file_extensions가 없으면 allowed_extensions를 사용한다.
+func (c *UnderscoreNumberConfig) fileFilter(now time.Time) (*fileMatcher, error) {
+	filter := c.FileFilter
+	if len(filter.FileExtensions) == 0 {
+		filter.FileExtensions = c.AllowedExtensions
+	}
+	return filter.compile(now)
 }
 
 func (u *UnderscoreNumber) Process(cfg *config.Config) error {
@@ -51,22 +115,66 @@ func (u *UnderscoreNumber) Process(cfg *config.Config) error {
 		}
 	}
 
+	if pluginConfig.KeepCount == 0 {
+		pluginConfig.KeepCount = 1
+	}
+	if pluginConfig.KeepCount < 0 {
+		return fmt.Errorf("keep_count must be positive: %d", pluginConfig.KeepCount)
+	}
+	switch pluginConfig.LatestBy {
+	case "":
+		pluginConfig.LatestBy = LatestByNumber
+	case LatestByNumber, LatestByModTime, LatestBySize, LatestByContent:
+	default:
+		return fmt.Errorf("unknown latest_by: %s", pluginConfig.LatestBy)
+	}
+
+	switch pluginConfig.Rename {
+	case "":
+		pluginConfig.Rename = RenameReset
+	case RenameNone, RenameReset, RenameStrip, RenamePadded:
+	case RenameTemplate:
+		if pluginConfig.RenameTemplate == "" {
+			return fmt.Errorf("rename_template is required for rename: template")
+		}
+	default:
+		return fmt.Errorf("unknown rename policy: %s", pluginConfig.Rename)
+	}
+	if pluginConfig.PadWidth == 0 {
+		pluginConfig.PadWidth = defaultPadWidth
+	}
+
+	patterns, err := compileNamePatterns(pluginConfig.Patterns)
+	if err != nil {
+		return err
+	}
+
+	filter, err := pluginConfig.fileFilter(time.Now())
+	if err != nil {
+		return err
+	}
+
 	// 작업할 폴더들 찾기
-	// cfg.WorkPath + underscorePluginConfig.TargetFolders + cfg.TargetDepth 조합
+	// cfg.WorkPath + target_folders + depth(없으면 cfg.TargetDepth) 조합
 	// 원하는 위치에서 파일 수집
-	for _, targetFolder := range pluginConfig.TargetFolders {
-		// workPath/targetFolder
-		basePath := filepath.Join(cfg.WorkPath, targetFolder)
-
-		// 작업할 경로
-		workDirs := utils.GetTargetDirs(basePath, cfg.TargetDepth)
+	var workDirs []string
+	switch pluginConfig.GroupScope {
+	case "", GroupScopeDirectory, GroupScopeSubtree:
+		workDirs, err = pluginConfig.workDirs(cfg)
+	case GroupScopeTargetFolder:
+		workDirs, err = pluginConfig.targetFolderPaths(cfg)
+	default:
+		return fmt.Errorf("unknown group_scope: %s", pluginConfig.GroupScope)
+	}
+	if err != nil {
+		return err
+	}
 
-		for _, finalDir := range workDirs {
-			count, err := processDir(finalDir, pluginConfig, log)
-			totalProcessed += count
-			if err != nil {
-				return err
-			}
+	for _, finalDir := range workDirs {
+		count, err := processDir(finalDir, pluginConfig, patterns, filter, log)
+		totalProcessed += count
+		if err != nil {
+			return err
 		}
 	}
 
@@ -74,7 +182,7 @@ func (u *UnderscoreNumber) Process(cfg *config.Config) error {
 
 	logFileName := fmt.Sprintf("underscore_number_log_%s.txt",
 		time.Now().Format("20060102_150405"))
-	logPath := filepath.Join(cfg.WorkPath, logFileName)
+	logPath := filepath.Join(cfg.GetLogPath(), logFileName)
 
 	if err := writeUnderscoreNumberLogFile(log, logPath); err != nil {
 		fmt.Printf("Warning: Failed to write log file: %v\n", err)
@@ -84,78 +192,258 @@ func (u *UnderscoreNumber) Process(cfg *config.Config) error {
 	return nil
 }
 
-func processDir(finalDir string, pluginConfig UnderscoreNumberConfig, log *UnderscoreNumberLog) (int, error) {
-	// 폴더 안의 파일들만 읽기(하위폴더 제외)
-	entires, err := os.ReadDir(finalDir)
+// processDir: finalDir 안의 파일을 그룹별로 정리한다.
+// group_scope가 directory가 아니면 하위 폴더의 파일까지 한 그룹으로 묶는다.
+func processDir(finalDir string, pluginConfig UnderscoreNumberConfig, patterns []*namePattern, filter *fileMatcher, log *UnderscoreNumberLog) (int, error) {
 	processFileCount := 0
 
-	if err != nil {
-		return processFileCount, err
-	}
-
-	//prefix_숫자.확장자 패턴인 파일만 읽기
-	// prefix별로 그룹핑
+	// 설정된 패턴(기본: prefix_숫자.확장자)에 맞는 파일만 읽기
+	// 패턴 + prefix별로 그룹핑
 	groups := make(map[string][]FileInfo)
+	brokenGroups := make(map[string]string) // 읽을 수 없는 파일이 있는 그룹 -> 그 파일 경로
 
-	for _, entry := range entires {
-		if entry.IsDir() {
-			continue
-		}
-
+	addFile := func(dir string, entry fs.DirEntry) {
 		filename := entry.Name()
-		prefix, number, ext, valid := parseFileName(filename)
+		fullPath := filepath.Join(dir, filename)
+		if !filter.match(fullPath, entry) {
+			return
+		}
+		parsed, valid, err := parseName(filename, patterns)
 		if !valid {
-			continue
+			return
+		}
+
+		// 패턴:prefix.pdf 형식으로 키 생성 (패턴마다 버전 비교 방식이 다름)
+		// prefix는 NFC, 확장자는 소문자로 맞춰서 퀴즈_1.pdf(NFD)와 퀴즈_2.PDF(NFC)를 같은 그룹으로 묶음
+		key := parsed.Pattern + ":" + parsed.Prefix + "." + utils.NormalizeExt(parsed.Ext)
+
+		if err != nil {
+			// 패턴에는 맞지만 버전을 읽을 수 없음 (숫자 범위 초과 등)
+			// 그 파일이 최신일 수 있으므로 그룹 전체를 건너뜀
+			log.SkippedFiles = append(log.SkippedFiles, fmt.Sprintf("%s: %v", fullPath, err))
+			if _, exists := brokenGroups[key]; !exists {
+				brokenGroups[key] = fullPath
+			}
+			return
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			log.SkippedFiles = append(log.SkippedFiles, fmt.Sprintf("%s: %v", fullPath, err))
+			if _, exists := brokenGroups[key]; !exists {
+				brokenGroups[key] = fullPath
+			}
+			return
 		}
 
-		// prefix.pdf 형식으로 키 생성
-		key := prefix + ext
 		groups[key] = append(groups[key], FileInfo{
 			FileName: filename,
-			Number:   number,
-			FullPath: filepath.Join(finalDir, filename),
+			Dir:      dir,
+			Prefix:   parsed.Prefix,
+			Version:  parsed.Version,
+			Ext:      parsed.Ext,
+			FullPath: fullPath,
+			ModTime:  info.ModTime(),
+			Size:     info.Size(),
 		})
 	}
 
-	// 각 그룹에서 최대 숫자 파일만 남기고 삭제
-	// 남은 파일을 prefix_1.확장자로 변경
-	for groupKey, files := range groups {
-		ext := filepath.Ext(groupKey)
+	if pluginConfig.GroupScope == "" || pluginConfig.GroupScope == GroupScopeDirectory {
+		// 폴더 안의 파일들만 읽기(하위폴더 제외)
+		entires, err := os.ReadDir(finalDir)
+		if err != nil {
+			return processFileCount, err
+		}
 
-		if !isAllowedExtension(ext, pluginConfig.AllowedExtensions) {
-			continue
+		for _, entry := range entires {
+			if !entry.IsDir() {
+				addFile(finalDir, entry)
+			}
 		}
+	} else {
+		// 하위 폴더까지 읽기
+		err := filepath.WalkDir(finalDir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() {
+				addFile(filepath.Dir(path), d)
+			}
+			return nil
+		})
+		if err != nil {
+			return processFileCount, err
+		}
+	}
 
-		// 최대 숫자 찾기
-		maxFile := files[0]
-		for _, file := range files {
-			if file.Number > maxFile.Number {
-				maxFile = file
+	// 각 그룹에서 최신 파일 keep_count개만 남기고 삭제
+	// 남은 파일은 rename 정책(기본: prefix_1.확장자)에 따라 변경
+	for key, files := range groups {
+		if brokenPath, broken := brokenGroups[key]; broken {
+			for _, file := range files {
+				log.SkippedFiles = append(log.SkippedFiles, fmt.Sprintf("%s: group skipped (%s could not be read)", file.FullPath, brokenPath))
 			}
+			continue
+		}
+
+		count, err := processGroup(finalDir, files, pluginConfig, log)
+		processFileCount += count
+		if err != nil {
+			return processFileCount, err
+		}
+	}
+
+	return processFileCount, nil
+}
+
+// processGroup: 같은 prefix 그룹에서 최신 파일만 남기고 나머지를 삭제한다.
+// finalDir: 그룹 범위의 기준 폴더
+func processGroup(finalDir string, files []FileInfo, pluginConfig UnderscoreNumberConfig, log *UnderscoreNumberLog) (int, error) {
+	processFileCount := 0
+
+	// 최신 순으로 정렬
+	ranked := rankLatest(files, pluginConfig.LatestBy)
+	keepCount := min(pluginConfig.KeepCount, len(ranked))
+
+	// 남길 파일과 삭제할 파일의 기준 값이 같으면 기록
+	if keepCount < len(ranked) && compareLatest(ranked[keepCount-1], ranked[keepCount], pluginConfig.LatestBy) == 0 {
+		log.Ties = append(log.Ties, fmt.Sprintf("%s: kept %s over %s (same %s)",
+			finalDir, ranked[keepCount-1].FileName, ranked[keepCount].FileName, pluginConfig.LatestBy))
+	}
+
+	// content: 모든 파일 내용이 같으면 삭제하지 않음
+	if pluginConfig.LatestBy == LatestByContent && len(ranked) > 1 {
+		identical, err := sameContent(ranked)
+		if err != nil {
+			return processFileCount, err
+		}
+		if identical {
+			log.KeptGroups = append(log.KeptGroups, fmt.Sprintf("%s: %d identical files (%s)",
+				finalDir, len(ranked), ranked[0].FileName))
+			return processFileCount, nil
 		}
+	}
+
+	// 나머지 파일 삭제
+	for _, file := range ranked[keepCount:] {
+		log.DeletedFiles = append(log.DeletedFiles, file.FullPath)
+		os.Remove(file.FullPath)
+		processFileCount++
+	}
 
-		for _, file := range files {
-			// 나머지 파일 삭제
-			if file.FullPath != maxFile.FullPath {
-				log.DeletedFiles = append(log.DeletedFiles, file.FullPath)
-				os.Remove(file.FullPath)
-				processFileCount++
-			} else {
-				// 파일 이름 변경
-				prefix, _, ext, _ := parseFileName(file.FileName)
-				newName := prefix + "_1" + ext
-				newPath := filepath.Join(finalDir, newName)
-
-				log.RenamedFiles[file.FullPath] = newPath
-				os.Rename(file.FullPath, newPath)
-				processFileCount++
+	// 남은 파일 이름 변경 - 오래된 파일부터 1, 2, ...
+	// survivor_location이 있으면 그 위치로 이동
+	var renames []utils.RenamePair
+	for i, file := range ranked[:keepCount] {
+		newName := survivorName(file, keepCount-i, i == 0, pluginConfig)
+		if newName == "" {
+			newName = file.FileName
+		}
+
+		targetDir := file.Dir
+		if pluginConfig.SurvivorLocation != "" {
+			targetDir = filepath.Join(finalDir, pluginConfig.SurvivorLocation)
+			if err := ensureDir(targetDir, true); err != nil {
+				return processFileCount, err
 			}
 		}
+
+		renames = append(renames, utils.RenamePair{From: file.FullPath, To: filepath.Join(targetDir, newName)})
+	}
+
+	// 이미 있는 파일과 겹치면 변경하지 않음
+	renames, conflicts := utils.PlanRenames(renames)
+	log.Collisions = append(log.Collisions, conflicts...)
+
+	done, err := utils.ApplyRenames(renames)
+	for _, rename := range done {
+		log.RenamedFiles[rename.From] = rename.To
+		processFileCount++
+	}
+	if err != nil {
+		return processFileCount, err
 	}
 
 	return processFileCount, nil
 }
 
+// survivorName: rename 정책에 따른 새 파일 이름 (변경하지 않으면 "")
+// n: 남은 파일 중 순번 (오래된 파일이 1), newest: 가장 최신 파일 여부
+// reset(기본값)과 padded는 원래 버전을 버리고 남은 파일 전체를 1..keep_count로 다시 매긴다.
+func survivorName(file FileInfo, n int, newest bool, pluginConfig UnderscoreNumberConfig) string {
+	switch pluginConfig.Rename {
+	case RenameReset:
+		return fmt.Sprintf("%s_%d%s", file.Prefix, n, file.Ext)
+	case RenamePadded:
+		return fmt.Sprintf("%s_%0*d%s", file.Prefix, pluginConfig.PadWidth, n, file.Ext)
+	case RenameStrip:
+		// 여러 개를 남기면 최신 파일만 prefix.ext로 변경
+		if !newest {
+			return ""
+		}
+		return file.Prefix + file.Ext
+	case RenameTemplate:
+		return strings.NewReplacer(
+			"{prefix}", file.Prefix,
+			"{version}", file.Version.Raw,
+			"{n}", strconv.Itoa(n),
+			"{ext}", file.Ext,
+		).Replace(pluginConfig.RenameTemplate)
+	default: // none
+		return ""
+	}
+}
+
+// compareLatest: latest_by 기준으로 a가 더 최신이면 양수
+func compareLatest(a, b FileInfo, latestBy string) int {
+	switch latestBy {
+	case LatestByModTime:
+		return a.ModTime.Compare(b.ModTime)
+	case LatestBySize:
+		return cmp.Compare(a.Size, b.Size)
+	default: // number, content
+		return a.Version.Compare(b.Version)
+	}
+}
+
+// rankLatest: 최신 파일이 앞에 오도록 정렬한 복사본을 반환한다.
+// 기준 값이 같으면 버전, 파일명 순으로 비교한다.
+func rankLatest(files []FileInfo, latestBy string) []FileInfo {
+	ranked := slices.Clone(files)
+	slices.SortFunc(ranked, func(a, b FileInfo) int {
+		if c := compareLatest(b, a, latestBy); c != 0 {
+			return c
+		}
+		if c := b.Version.Compare(a.Version); c != 0 {
+			return c
+		}
+		return strings.Compare(b.FileName, a.FileName)
+	})
+	return ranked
+}
+
+// sameContent: 모든 파일의 내용이 같은지 확인한다.
+func sameContent(files []FileInfo) (bool, error) {
+	var firstHash string
+	for i, file := range files {
+		if file.Size != files[0].Size {
+			return false, nil
+		}
+
+		hash, err := utils.HashFile(file.FullPath, 0)
+		if err != nil {
+			return false, err
+		}
+
+		if i == 0 {
+			firstHash = hash
+		} else if hash != firstHash {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func writeUnderscoreNumberLogFile(log *UnderscoreNumberLog, logPath string) error {
 	file, err := os.Create(logPath)
 	if err != nil {
@@ -176,53 +464,37 @@ func writeUnderscoreNumberLogFile(log *UnderscoreNumberLog, logPath string) erro
 		fmt.Fprintf(file, "RENAMED: %s -> %s\n", original, renamed)
 	}
 
-	return nil
-}
-
-func parseFileName(filename string) (prefix string, number int, ext string, valid bool) {
-	// 확장자 분리
-	ext = filepath.Ext(filename)
-	nameWithoutExt := strings.TrimSuffix(filename, ext)
-
-	// _ 찾기
-	lastUnderscoreIndex := strings.LastIndex(nameWithoutExt, "_")
-	if lastUnderscoreIndex == -1 {
-		return "", 0, "", false
+	fmt.Fprintf(file, "\n=== RENAME COLLISIONS ===\n")
+	for _, collision := range log.Collisions {
+		fmt.Fprintf(file, "COLLISION: %s\n", collision)
 	}
 
-	// prefix와 숫자부분 분리
-	prefix = nameWithoutExt[:lastUnderscoreIndex]
-	numberStr := nameWithoutExt[lastUnderscoreIndex+1:]
-
-	if prefix == "" {
-		return "", 0, "", false
+	fmt.Fprintf(file, "\n=== SKIPPED FILES ===\n")
+	for _, skipped := range log.SkippedFiles {
+		fmt.Fprintf(file, "SKIPPED: %s\n", skipped)
 	}
 
-	// 숫자 변환
-	number, err := strconv.Atoi(numberStr)
-	if err != nil {
-		return "", 0, "", false // 숫자가 아닌 경우
+	fmt.Fprintf(file, "\n=== TIES ===\n")
+	for _, tie := range log.Ties {
+		fmt.Fprintf(file, "TIE: %s\n", tie)
 	}
 
-	return prefix, number, ext, true
-}
-
-func isAllowedExtension(ext string, allowedExtensions []string) bool {
-	// 설정이 없으면 모든 확장자 허용
-	if len(allowedExtensions) == 0 {
-		return true
+	fmt.Fprintf(file, "\n=== KEPT (IDENTICAL CONTENT) ===\n")
+	for _, kept := range log.KeptGroups {
+		fmt.Fprintf(file, "KEPT: %s\n", kept)
 	}
 
-	// 점 제거
-	ext = strings.TrimPrefix(ext, ".")
+	return nil
+}
 
-	for _, allowed := range allowedExtensions {
-		if ext == allowed {
-			return true
-		}
+// parseFileName 기본 패턴(prefix_숫자.확장자)으로 파일명을 해석한다.
+func parseFileName(filename string) (prefix string, number int, ext string, valid bool) {
+	parsed, ok, err := defaultNamePattern.match(filename)
+	if !ok || err != nil {
+		return "", 0, "", false
 	}
 
-	return false
+	return parsed.Prefix, int(parsed.Version.Number()), parsed.Ext, true
 }
 
 func (u *UnderscoreNumber) GetName() string {
@@ -0,0 +1,261 @@
+package runs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 실행 상태
+const (
+	StatusRunning  = "running"
+	StatusSuccess  = "success"
+	StatusFailed   = "failed"
+	StatusNotReady = "not_ready"
+)
+
+// run 디렉터리 안의 파일 이름
+const (
+	summaryFile    = "run.json"
+	configFile     = "config.json"
+	scanReportFile = "scan_report.json"
+	timingsFile    = "timings.json"
+	journalFile    = "journal.log"
+	logsDir        = "logs"
+)
+
+// Summary: 한 번의 실행 정보 (run.json)
+type Summary struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name,omitempty"` // 워크스페이스 이름
+	ConfigPath string    `json:"config_path"`
+	WorkPath   string    `json:"work_path"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+// Timings: 단계별 작업 시간 (timings.json)
+type Timings struct {
+	Copy    string         `json:"copy,omitempty"`
+	Process string         `json:"process,omitempty"`
+	Total   string         `json:"total,omitempty"`
+	Plugins []PluginTiming `json:"plugins,omitempty"`
+}
+
+type PluginTiming struct {
+	Name     string `json:"name"`
+	Duration string `json:"duration"`
+}
+
+// Run: 실행 중인 run 디렉터리
+type Run struct {
+	Summary Summary
+	Timings Timings
+	Dir     string
+	journal *os.File
+}
+
+// DefaultDir: runs_path 설정이 없을 때 사용하는 기본 경로 (~/.filemanager/runs)
+func DefaultDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".filemanager", "runs")
+	}
+	return filepath.Join(home, ".filemanager", "runs")
+}
+
+// Start: 새 run ID를 만들고 run 디렉터리를 생성한다.
+// ID는 "시작시각_워크스페이스이름" 형식이며 이름이 없으면 시작시각만 사용한다.
+func Start(baseDir, name, configPath, workPath string) (*Run, error) {
+	startedAt := time.Now()
+	id := startedAt.Format("20060102_150405")
+	if name != "" {
+		id += "_" + strings.Map(func(r rune) rune {
+			if r == '/' || r == '\\' || r == ' ' {
+				return '_'
+			}
+			return r
+		}, name)
+	}
+
+	// 같은 초에 시작한 run이 있으면 번호를 붙인다
+	dir := filepath.Join(baseDir, id)
+	for i := 2; ; i++ {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			break
+		}
+		dir = filepath.Join(baseDir, fmt.Sprintf("%s_%d", id, i))
+	}
+	id = filepath.Base(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, logsDir), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create run directory: %v", err)
+	}
+
+	journal, err := os.Create(filepath.Join(dir, journalFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create journal: %v", err)
+	}
+
+	r := &Run{
+		Summary: Summary{
+			ID:         id,
+			Name:       name,
+			ConfigPath: configPath,
+			WorkPath:   workPath,
+			Status:     StatusRunning,
+			StartedAt:  startedAt,
+		},
+		Dir:     dir,
+		journal: journal,
+	}
+
+	r.Logf("run %s started (config: %s)", id, configPath)
+	return r, r.writeSummary()
+}
+
+// LogsDir: 플러그인 로그를 저장할 경로
+func (r *Run) LogsDir() string {
+	return filepath.Join(r.Dir, logsDir)
+}
+
+// Logf: journal.log에 시간과 함께 한 줄을 기록한다.
+func (r *Run) Logf(format string, args ...any) {
+	if r.journal == nil {
+		return
+	}
+	fmt.Fprintf(r.journal, "%s %s\n", time.Now().Format("2006-01-02 15:04:05"), fmt.Sprintf(format, args...))
+}
+
+// SaveConfig: 실제로 사용된 설정을 저장한다.
+func (r *Run) SaveConfig(cfg any) error {
+	return writeJSON(filepath.Join(r.Dir, configFile), cfg)
+}
+
+// SaveScanReport: ScanFiles 결과를 저장한다.
+func (r *Run) SaveScanReport(report any) error {
+	return writeJSON(filepath.Join(r.Dir, scanReportFile), report)
+}
+
+// AddPluginTiming: 플러그인 작업 시간을 기록한다.
+func (r *Run) AddPluginTiming(name string, d time.Duration) {
+	r.Timings.Plugins = append(r.Timings.Plugins, PluginTiming{Name: name, Duration: d.String()})
+	r.Logf("plugin %s finished in %v", name, d)
+}
+
+// Finish: 실행 결과와 작업 시간을 저장하고 journal을 닫는다.
+func (r *Run) Finish(status string, runErr error) error {
+	r.Summary.Status = status
+	r.Summary.FinishedAt = time.Now()
+	if runErr != nil {
+		r.Summary.Error = runErr.Error()
+		r.Logf("run failed: %v", runErr)
+	}
+	r.Timings.Total = r.Summary.FinishedAt.Sub(r.Summary.StartedAt).String()
+	r.Logf("run finished with status %s", status)
+
+	if r.journal != nil {
+		r.journal.Close()
+		r.journal = nil
+	}
+
+	if err := writeJSON(filepath.Join(r.Dir, timingsFile), r.Timings); err != nil {
+		return err
+	}
+	return r.writeSummary()
+}
+
+func (r *Run) writeSummary() error {
+	return writeJSON(filepath.Join(r.Dir, summaryFile), r.Summary)
+}
+
+// List: baseDir의 모든 run을 시작 시간 순으로 반환한다.
+func List(baseDir string) ([]Summary, error) {
+	entries, err := os.ReadDir(baseDir)
+	if os.IsNotExist(err) {
+		return nil, nil // 아직 실행 기록 없음
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var summaries []Summary
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		var summary Summary
+		if err := readJSON(filepath.Join(baseDir, entry.Name(), summaryFile), &summary); err != nil {
+			continue // run.json이 없는 폴더는 스킵
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].StartedAt.Before(summaries[j].StartedAt)
+	})
+	return summaries, nil
+}
+
+// Details: runs show에서 보여줄 run 정보
+type Details struct {
+	Summary Summary
+	Timings Timings
+	Dir     string
+	Logs    []string // 플러그인 로그 파일 경로
+}
+
+// Load: baseDir에서 id에 해당하는 run 정보를 읽는다.
+func Load(baseDir, id string) (*Details, error) {
+	// id는 명령줄에서 받으므로 runs 폴더 밖을 가리키지 못하게 한다
+	if id == "" || id == "." || strings.Contains(id, "..") || strings.ContainsAny(id, `/\`) {
+		return nil, fmt.Errorf("invalid run id: %s", id)
+	}
+
+	dir := filepath.Join(baseDir, id)
+	details := &Details{Dir: dir}
+
+	if err := readJSON(filepath.Join(dir, summaryFile), &details.Summary); err != nil {
+		return nil, fmt.Errorf("run not found: %s", id)
+	}
+
+	// 실행 중 중단된 run은 timings.json이 없을 수 있다
+	readJSON(filepath.Join(dir, timingsFile), &details.Timings)
+
+	entries, _ := os.ReadDir(filepath.Join(dir, logsDir))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			details.Logs = append(details.Logs, filepath.Join(dir, logsDir, entry.Name()))
+		}
+	}
+
+	return details, nil
+}
+
+// JournalPath: run의 journal.log 경로
+func (d *Details) JournalPath() string {
+	return filepath.Join(d.Dir, journalFile)
+}
+
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
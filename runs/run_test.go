@@ -0,0 +1,59 @@
+package runs
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRunHistory(t *testing.T) {
+	baseDir := t.TempDir()
+
+	run, err := Start(baseDir, "class a", "config.json", "/work")
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	run.AddPluginTiming("underscore_number", 0)
+
+	if err := run.Finish(StatusFailed, errors.New("boom")); err != nil {
+		t.Fatalf("Finish failed: %v", err)
+	}
+
+	summaries, err := List(baseDir)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].ID != run.Summary.ID {
+		t.Fatalf("expected run %q in list, got %+v", run.Summary.ID, summaries)
+	}
+
+	details, err := Load(baseDir, run.Summary.ID)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if details.Summary.Status != StatusFailed || details.Summary.Error != "boom" {
+		t.Errorf("unexpected summary: %+v", details.Summary)
+	}
+	if len(details.Timings.Plugins) != 1 {
+		t.Errorf("expected 1 plugin timing, got %d", len(details.Timings.Plugins))
+	}
+
+	// 같은 초에 시작해도 다른 ID를 받아야 한다
+	second, err := Start(baseDir, "class a", "config.json", "/work")
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	second.Finish(StatusSuccess, nil)
+	if second.Summary.ID == run.Summary.ID {
+		t.Errorf("duplicate run id: %s", second.Summary.ID)
+	}
+}
+
+func TestLoadRejectsPathIDs(t *testing.T) {
+	baseDir := t.TempDir()
+	for _, id := range []string{"", ".", "..", "../x", "../../etc", "a/b", `a\b`} {
+		if _, err := Load(baseDir, id); err == nil || !strings.Contains(err.Error(), "invalid run id") {
+			t.Errorf("expected invalid run id error for %q, got %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package utils
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// 압축 형식
+const (
+	ArchiveZip   = "zip"
+	ArchiveTar   = "tar" // 압축 해제만 지원
+	ArchiveTarGz = "tar.gz"
+)
+
+// ArchiveEntry: 압축 파일에 넣을 파일 한 건
+type ArchiveEntry struct {
+	Path string // 원본 파일 전체 경로
+	Name string // 압축 파일 안의 경로 (/ 구분)
+}
+
+// ArchiveFormat: 파일명의 확장자로 압축 형식을 판별한다. 지원하지 않으면 ""
+func ArchiveFormat(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return ArchiveZip
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return ArchiveTarGz
+	case strings.HasSuffix(lower, ".tar"):
+		return ArchiveTar
+	default:
+		return ""
+	}
+}
+
+// WriteArchive: entries를 format 형식의 압축 파일로 만든다. 실패하면 만들던 파일을 삭제한다.
+func WriteArchive(archivePath, format string, entries []ArchiveEntry) (err error) {
+	file, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(archivePath)
+		}
+	}()
+
+	switch format {
+	case ArchiveZip:
+		return writeZip(file, entries)
+	case ArchiveTarGz:
+		return writeTarGz(file, entries)
+	default:
+		return fmt.Errorf("unknown archive format: %s", format)
+	}
+}
+
+func writeZip(w io.Writer, entries []ArchiveEntry) error {
+	zw := zip.NewWriter(w)
+	for _, entry := range entries {
+		info, err := os.Stat(entry.Path)
+		if err != nil {
+			return err
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = entry.Name
+		header.Method = zip.Deflate
+
+		dst, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if err := copyFileTo(dst, entry.Path); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeTarGz(w io.Writer, entries []ArchiveEntry) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, entry := range entries {
+		info, err := os.Stat(entry.Path)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = entry.Name
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if err := copyFileTo(tw, entry.Path); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func copyFileTo(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// HashArchive: 압축 파일 안의 각 파일 경로와 내용의 SHA-256 해시(hex)를 반환한다. 폴더 항목은 제외한다.
+func HashArchive(archivePath, format string) (map[string]string, error) {
+	hashes := make(map[string]string)
+	hashEntry := func(name string, r io.Reader) error {
+		h := sha256.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return err
+		}
+		hashes[name] = hex.EncodeToString(h.Sum(nil))
+		return nil
+	}
+
+	switch format {
+	case ArchiveZip:
+		zr, err := zip.OpenReader(archivePath)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+
+		for _, f := range zr.File {
+			if f.FileInfo().IsDir() {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			err = hashEntry(f.Name, rc)
+			rc.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+	case ArchiveTarGz:
+		file, err := os.Open(archivePath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		gr, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		tr := tar.NewReader(gr)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if header.Typeflag != tar.TypeReg {
+				continue
+			}
+			if err := hashEntry(header.Name, tr); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown archive format: %s", format)
+	}
+
+	return hashes, nil
+}
+
+// VerifyArchive: 압축 파일에 entries가 모두 같은 내용으로 들어 있는지 확인한다.
+func VerifyArchive(archivePath, format string, entries []ArchiveEntry) error {
+	hashes, err := HashArchive(archivePath, format)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		archived, ok := hashes[entry.Name]
+		if !ok {
+			return fmt.Errorf("missing in archive: %s", entry.Name)
+		}
+		original, err := HashFile(entry.Path, 0)
+		if err != nil {
+			return err
+		}
+		if archived != original {
+			return fmt.Errorf("content mismatch in archive: %s", entry.Name)
+		}
+	}
+	return nil
+}
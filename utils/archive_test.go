@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteAndVerifyArchive(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "sub"), 0755)
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0644)
+	os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("world"), 0644)
+
+	entries := []ArchiveEntry{
+		{Path: filepath.Join(dir, "a.txt"), Name: "a.txt"},
+		{Path: filepath.Join(dir, "sub", "b.txt"), Name: "sub/b.txt"},
+	}
+
+	for _, format := range []string{ArchiveZip, ArchiveTarGz} {
+		archivePath := filepath.Join(dir, "out."+format)
+		if ArchiveFormat(archivePath) != format {
+			t.Errorf("ArchiveFormat(%s) != %s", archivePath, format)
+		}
+
+		if err := WriteArchive(archivePath, format, entries); err != nil {
+			t.Fatalf("%s: WriteArchive failed: %v", format, err)
+		}
+		if err := VerifyArchive(archivePath, format, entries); err != nil {
+			t.Errorf("%s: VerifyArchive failed: %v", format, err)
+		}
+
+		// 원본이 바뀌면 검증 실패
+		os.WriteFile(filepath.Join(dir, "a.txt"), []byte("changed"), 0644)
+		if err := VerifyArchive(archivePath, format, entries); err == nil {
+			t.Errorf("%s: expected content mismatch", format)
+		}
+		os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0644)
+	}
+}
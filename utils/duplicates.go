@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"sort"
+)
+
+// partialHashSize 부분 해시에 사용할 앞부분 크기
+const partialHashSize = 64 * 1024
+
+// DuplicateGroup: 내용이 같은(또는 같다고 추정되는) 파일 목록
+type DuplicateGroup struct {
+	Size  int64
+	Paths []string // 정렬됨
+}
+
+// DuplicateBytes: 하나만 남기고 지웠을 때 줄어드는 크기
+func (g DuplicateGroup) DuplicateBytes() int64 {
+	return g.Size * int64(len(g.Paths)-1)
+}
+
+// FindDuplicates: 내용이 같은 파일을 찾는다.
+// 크기 -> 앞부분 해시 -> 전체 해시 순으로 후보를 줄이며, fullHash가 false면
+// 앞부분 해시까지만 비교한 추정 결과를 반환한다. 빈 파일은 제외한다.
+func FindDuplicates(paths []string, fullHash bool) ([]DuplicateGroup, error) {
+	return findDuplicates(paths, fullHash, func(path string, err error) error {
+		return err
+	})
+}
+
+// EstimateDuplicateBytes: 앞부분 해시까지 비교한 중복 크기 추정치.
+// 읽을 수 없는 파일은 건너뛰고 skipped로 반환한다.
+func EstimateDuplicateBytes(paths []string) (total int64, skipped []string) {
+	groups, _ := findDuplicates(paths, false, func(path string, err error) error {
+		skipped = append(skipped, path)
+		return nil
+	})
+	for _, group := range groups {
+		total += group.DuplicateBytes()
+	}
+	return total, skipped
+}
+
+// findDuplicates: onError가 nil을 반환하면 읽을 수 없는 파일을 건너뛰고 계속한다.
+func findDuplicates(paths []string, fullHash bool, onError func(path string, err error) error) ([]DuplicateGroup, error) {
+	// 1. 크기별 그룹
+	bySize := make(map[int64][]string)
+	for _, path := range paths {
+		info, err := os.Lstat(path)
+		if err != nil || !info.Mode().IsRegular() || info.Size() == 0 {
+			continue // 일반 파일이 아니거나 빈 파일은 스킵
+		}
+		bySize[info.Size()] = append(bySize[info.Size()], path)
+	}
+
+	var groups []DuplicateGroup
+	for size, candidates := range bySize {
+		if len(candidates) < 2 {
+			continue
+		}
+
+		// 2. 앞부분 해시
+		partialGroups, err := groupByHash(candidates, partialHashSize, onError)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, partial := range partialGroups {
+			// 앞부분이 파일 전체면 전체 해시와 같음
+			if !fullHash || size <= partialHashSize {
+				groups = append(groups, DuplicateGroup{Size: size, Paths: partial})
+				continue
+			}
+
+			// 3. 전체 해시
+			fullGroups, err := groupByHash(partial, 0, onError)
+			if err != nil {
+				return nil, err
+			}
+			for _, full := range fullGroups {
+				groups = append(groups, DuplicateGroup{Size: size, Paths: full})
+			}
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Paths[0] < groups[j].Paths[0]
+	})
+	return groups, nil
+}
+
+// groupByHash: 해시가 같은 파일끼리 묶어 2개 이상인 그룹만 반환한다.
+func groupByHash(paths []string, limit int64, onError func(path string, err error) error) ([][]string, error) {
+	byHash := make(map[string][]string)
+	for _, path := range paths {
+		hash, err := HashFile(path, limit)
+		if err != nil {
+			if err := onError(path, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		byHash[hash] = append(byHash[hash], path)
+	}
+
+	var groups [][]string
+	for _, group := range byHash {
+		if len(group) > 1 {
+			sort.Strings(group)
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// HashFile: 파일의 SHA-256 해시 (hex). limit > 0이면 앞부분 limit 바이트만 사용한다.
+func HashFile(path string, limit int64) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if limit > 0 {
+		r = io.LimitReader(f, limit)
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
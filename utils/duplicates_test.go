@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		os.WriteFile(path, data, 0644)
+		return path
+	}
+
+	// 앞부분은 같고 끝만 다른 큰 파일
+	big := bytes.Repeat([]byte("x"), partialHashSize+10)
+	bigOther := append(bytes.Repeat([]byte("x"), partialHashSize+9), 'y')
+
+	paths := []string{
+		write("a.txt", []byte("hello")),
+		write("b.txt", []byte("hello")),
+		write("c.txt", []byte("world")),
+		write("empty1.txt", nil),
+		write("empty2.txt", nil),
+		write("big1.bin", big),
+		write("big2.bin", bigOther),
+	}
+
+	full, err := FindDuplicates(paths, true)
+	if err != nil {
+		t.Fatalf("FindDuplicates failed: %v", err)
+	}
+	if len(full) != 1 || len(full[0].Paths) != 2 || full[0].DuplicateBytes() != 5 {
+		t.Errorf("expected one 5-byte duplicate group, got %+v", full)
+	}
+
+	// 앞부분 해시만 비교하면 큰 파일도 중복으로 추정
+	estimate, err := FindDuplicates(paths, false)
+	if err != nil {
+		t.Fatalf("FindDuplicates failed: %v", err)
+	}
+	if len(estimate) != 2 {
+		t.Errorf("expected 2 estimated groups, got %+v", estimate)
+	}
+}
+
+func TestEstimateDuplicateBytesSkipsUnreadable(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt"} {
+		os.WriteFile(filepath.Join(dir, name), []byte("hello"), 0644)
+	}
+	paths := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")}
+
+	total, skipped := EstimateDuplicateBytes(paths)
+	if total != 5 || len(skipped) != 0 {
+		t.Errorf("expected 5 bytes, no skipped, got %d, %v", total, skipped)
+	}
+
+	// 크기 확인 뒤 읽지 못한 파일은 추정에서 빼고 계속한다
+	missing := filepath.Join(dir, "missing.txt")
+	var failed []string
+	groups, err := groupByHash(append(paths, missing), partialHashSize, func(path string, err error) error {
+		failed = append(failed, path)
+		return nil
+	})
+	if err != nil || len(groups) != 1 || len(failed) != 1 || failed[0] != missing {
+		t.Errorf("expected unreadable file skipped, got %v, %v, %v", groups, failed, err)
+	}
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// RemoveEmptyDirs: root 아래의 빈 폴더를 하위 폴더부터(bottom-up) 삭제하고 삭제한 경로를 반환한다.
+// 하위 폴더가 삭제되어 비게 된 폴더도 삭제한다. root 자체는 삭제하지 않는다.
+// excludes: 삭제하지 않을 폴더 - 폴더 이름 또는 root 기준 상대 경로의 glob 패턴
+func RemoveEmptyDirs(root string, excludes []string) ([]string, error) {
+	var removed []string
+	_, err := removeEmptyDirs(root, root, excludes, &removed)
+	return removed, err
+}
+
+// RemoveDirIfEmpty: dir이 비어 있고 excludes에 해당하지 않으면 삭제한다.
+// excludes는 base 기준 상대 경로 또는 폴더 이름으로 비교한다.
+func RemoveDirIfEmpty(base, dir string, excludes []string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+	if len(entries) > 0 || isExcludedDir(base, dir, excludes) {
+		return false, nil
+	}
+	if err := os.Remove(dir); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// removeEmptyDirs: dir의 하위 빈 폴더를 삭제하고 dir이 비었는지 반환한다.
+func removeEmptyDirs(root, dir string, excludes []string, removed *[]string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+
+	empty := true
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			empty = false
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		childEmpty, err := removeEmptyDirs(root, path, excludes, removed)
+		if err != nil {
+			return false, err
+		}
+
+		if !childEmpty || isExcludedDir(root, path, excludes) {
+			empty = false
+			continue
+		}
+
+		if err := os.Remove(path); err != nil {
+			return false, err
+		}
+		*removed = append(*removed, path)
+	}
+
+	return empty, nil
+}
+
+// isExcludedDir: path가 excludes의 폴더 이름 또는 상대 경로 패턴과 일치하는지 확인
+func isExcludedDir(root, path string, excludes []string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	rel = NormalizeName(filepath.ToSlash(rel))
+	name := NormalizeName(filepath.Base(path))
+
+	for _, exclude := range excludes {
+		pattern := NormalizeName(filepath.ToSlash(filepath.Clean(exclude)))
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+		if matched, _ := filepath.Match(pattern, rel); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func TestRemoveEmptyDirs(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"a/b/c", "keep", "d/e", "f/g"} {
+		os.MkdirAll(filepath.Join(root, dir), 0755)
+	}
+	os.WriteFile(filepath.Join(root, "d", "file.txt"), nil, 0644)
+
+	removed, err := RemoveEmptyDirs(root, []string{"keep", "f/g"})
+	if err != nil {
+		t.Fatalf("RemoveEmptyDirs failed: %v", err)
+	}
+
+	var got []string
+	for _, path := range removed {
+		rel, _ := filepath.Rel(root, path)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	sort.Strings(got)
+
+	// a/b/c -> a/b -> a 순서로 비게 되고, d는 파일이 있고, f는 제외된 f/g가 남아 유지
+	expected := []string{"a", "a/b", "a/b/c", "d/e"}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	}
+
+	for _, dir := range []string{"keep", "f/g", "d"} {
+		if _, err := os.Stat(filepath.Join(root, dir)); err != nil {
+			t.Errorf("%s should remain: %v", dir, err)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// WHATWG 이름에 없는 자주 쓰는 별칭
+var encodingAliases = map[string]string{
+	"cp949": "windows-949",
+	"uhc":   "windows-949",
+	"cp932": "windows-31j",
+	"cp936": "gbk",
+}
+
+// LookupEncoding: 이름(cp949, euc-kr, shift_jis 등)으로 문자 인코딩을 찾는다. 대소문자 무시
+func LookupEncoding(name string) (encoding.Encoding, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if alias, ok := encodingAliases[key]; ok {
+		key = alias
+	}
+
+	enc, err := htmlindex.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("unknown encoding: %s", name)
+	}
+	return enc, nil
+}
+
+// 인코딩 판별 결과
+const (
+	EncodingUTF8    = "utf-8"
+	EncodingUTF16LE = "utf-16le"
+	EncodingUTF16BE = "utf-16be"
+)
+
+// TextEncoding: DetectEncoding 결과
+type TextEncoding struct {
+	Name      string // utf-8, utf-16le, utf-16be 또는 candidates 중 하나 ("" = 판별 실패)
+	BOMLength int    // BOM 바이트 수 (없으면 0)
+	Binary    bool   // 텍스트 파일이 아님
+	Confident bool   // 판별 결과를 믿을 수 있는지
+	Reason    string // 확신하지 못한 이유
+}
+
+// 디코딩 결과 중 글자(한글, 한자, 라틴 등)의 비율이 이 값 이상이어야 해당 인코딩으로 판단
+const minLetterRatio = 0.9
+
+// DetectEncoding: BOM → UTF-8 유효성 → candidates(cp949 등) 순서로 텍스트 인코딩을 판별한다.
+// NUL 바이트나 제어 문자가 많으면 바이너리로 판단한다.
+func DetectEncoding(data []byte, candidates []string) TextEncoding {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		return TextEncoding{Name: EncodingUTF8, BOMLength: 3, Confident: true}
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}):
+		return TextEncoding{Name: EncodingUTF16LE, BOMLength: 2, Confident: true}
+	case bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		return TextEncoding{Name: EncodingUTF16BE, BOMLength: 2, Confident: true}
+	}
+
+	if isBinary(data) {
+		return TextEncoding{Binary: true, Confident: true}
+	}
+
+	if utf8.Valid(data) {
+		return TextEncoding{Name: EncodingUTF8, Confident: true}
+	}
+
+	// 오류 없이 디코딩되고 글자 비율이 높은 후보를 찾는다
+	// 잘못된 바이트가 하나라도 있으면(U+FFFD로 바뀜) 변환 시 원본이 손상되므로 제외한다
+	var matches, lossy []string
+	for _, candidate := range candidates {
+		enc, err := LookupEncoding(candidate)
+		if err != nil {
+			continue
+		}
+		decoded, err := enc.NewDecoder().Bytes(data)
+		if err != nil {
+			continue
+		}
+		if bytes.ContainsRune(decoded, utf8.RuneError) {
+			lossy = append(lossy, candidate)
+			continue
+		}
+		if letterRatio(decoded) < minLetterRatio {
+			continue
+		}
+		matches = append(matches, candidate)
+	}
+
+	switch len(matches) {
+	case 0:
+		if len(lossy) > 0 {
+			return TextEncoding{Reason: fmt.Sprintf("invalid byte sequences for %s", strings.Join(lossy, ", "))}
+		}
+		return TextEncoding{Reason: "not valid UTF-8 and no candidate encoding matched"}
+	case 1:
+		return TextEncoding{Name: matches[0], Confident: true}
+	default:
+		return TextEncoding{Name: matches[0], Reason: fmt.Sprintf("multiple encodings matched: %s", strings.Join(matches, ", "))}
+	}
+}
+
+// isBinary: NUL 바이트가 있거나 제어 문자(탭, 줄바꿈 등 제외)가 10% 이상이면 바이너리
+func isBinary(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+
+	control := 0
+	for _, b := range data {
+		switch {
+		case b == 0:
+			return true
+		case b < 0x20 && b != '\t' && b != '\n' && b != '\r' && b != '\f':
+			control++
+		}
+	}
+	return control*10 >= len(data)
+}
+
+// letterRatio: ASCII가 아닌 문자 중 글자/문장부호의 비율
+func letterRatio(text []byte) float64 {
+	total, letters := 0, 0
+	for _, r := range string(text) {
+		if r < utf8.RuneSelf {
+			continue
+		}
+		total++
+		if unicode.IsLetter(r) || unicode.IsPunct(r) || unicode.IsSpace(r) {
+			letters++
+		}
+	}
+	if total == 0 {
+		return 1
+	}
+	return float64(letters) / float64(total)
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"testing"
+
+	"golang.org/x/text/encoding/korean"
+)
+
+func TestDetectEncoding(t *testing.T) {
+	cp949, _ := korean.EUCKR.NewEncoder().Bytes([]byte("이름,점수\n홍길동,90\n"))
+
+	tests := []struct {
+		name      string
+		data      []byte
+		expected  string
+		binary    bool
+		confident bool
+	}{
+		{"ascii", []byte("name,score\n"), EncodingUTF8, false, true},
+		{"utf-8", []byte("이름,점수\n"), EncodingUTF8, false, true},
+		{"utf-8 bom", append([]byte{0xEF, 0xBB, 0xBF}, "a"...), EncodingUTF8, false, true},
+		{"utf-16le bom", []byte{0xFF, 0xFE, 'a', 0}, EncodingUTF16LE, false, true},
+		{"cp949", cp949, "cp949", false, true},
+		{"binary", []byte{0x89, 'P', 'N', 'G', 0, 0, 1}, "", true, true},
+		{"unknown", []byte{0xFF, 0xFF, 0xFF, 0xFF}, "", false, false},
+		// 대부분 정상인 cp949라도 잘못된 바이트가 있으면 변환하지 않음
+		{"cp949 with invalid bytes", append(append([]byte{}, cp949...), 0xFF, 0xFF), "", false, false},
+	}
+
+	for _, tc := range tests {
+		got := DetectEncoding(tc.data, []string{"cp949"})
+		if got.Name != tc.expected || got.Binary != tc.binary || got.Confident != tc.confident {
+			t.Errorf("%s: got %+v", tc.name, got)
+		}
+	}
+}
+
+func TestLookupEncoding(t *testing.T) {
+	for _, name := range []string{"cp949", "CP949", "euc-kr", "shift_jis"} {
+		if _, err := LookupEncoding(name); err != nil {
+			t.Errorf("LookupEncoding(%s) failed: %v", name, err)
+		}
+	}
+	if _, err := LookupEncoding("no-such-encoding"); err == nil {
+		t.Errorf("expected error for unknown encoding")
+	}
+}
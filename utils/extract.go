@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ExtractOptions: 압축 해제 제한
+type ExtractOptions struct {
+	DecodeName   func(name string) string // 압축 파일 안의 경로 변환 (nil이면 그대로)
+	MaxTotalSize int64                    // 풀린 파일 전체 크기 제한 (바이트, 0이면 제한 없음)
+	MaxEntries   int                      // 파일 개수 제한 (0이면 제한 없음)
+	MaxRatio     int64                    // zip 항목의 압축률 제한 (원본/압축, 0이면 제한 없음)
+}
+
+// ExtractArchive: 압축 파일을 destDir에 풀고 만든 파일 경로를 반환한다.
+// destDir 밖을 가리키는 경로(zip slip)나 제한을 넘는 압축 파일(zip bomb)은 에러를 반환한다.
+// 에러가 나면 이미 풀린 파일은 남아 있으므로 호출하는 쪽에서 destDir을 정리한다.
+func ExtractArchive(archivePath, format, destDir string, opts ExtractOptions) ([]string, error) {
+	x := &extractor{destDir: destDir, opts: opts}
+
+	// x.files는 압축을 풀면서 채워지므로 먼저 실행한 뒤 반환한다
+	var err error
+	switch format {
+	case ArchiveZip:
+		err = x.extractZip(archivePath)
+	case ArchiveTar, ArchiveTarGz:
+		err = x.extractTar(archivePath, format == ArchiveTarGz)
+	default:
+		return nil, fmt.Errorf("unknown archive format: %s", format)
+	}
+	return x.files, err
+}
+
+type extractor struct {
+	destDir string
+	opts    ExtractOptions
+	files   []string
+	written int64
+}
+
+func (x *extractor) extractZip(archivePath string) error {
+	zr, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		if x.opts.MaxRatio > 0 && f.CompressedSize64 > 0 &&
+			f.UncompressedSize64/f.CompressedSize64 > uint64(x.opts.MaxRatio) {
+			return fmt.Errorf("compression ratio too high: %s", f.Name)
+		}
+
+		if f.FileInfo().IsDir() {
+			if _, err := x.mkdir(f.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		if !f.Mode().IsRegular() {
+			continue // 심볼릭 링크 등은 풀지 않음
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = x.writeFile(f.Name, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *extractor) extractTar(archivePath string, gzipped bool) error {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if gzipped {
+		gr, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		r = gr
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if _, err := x.mkdir(header.Name); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := x.writeFile(header.Name, tr); err != nil {
+				return err
+			}
+		default:
+			continue // 링크, 장치 파일 등은 풀지 않음
+		}
+	}
+}
+
+// targetPath: 압축 파일 안의 경로를 destDir 아래의 경로로 바꾼다. destDir 밖이면 에러
+func (x *extractor) targetPath(name string) (string, error) {
+	if x.opts.DecodeName != nil {
+		name = x.opts.DecodeName(name)
+	}
+	name = strings.ReplaceAll(name, `\`, "/")
+
+	// 절대 경로, 드라이브 문자, .. 가 있는 경로는 거부 (zip slip)
+	if path.IsAbs(name) || filepath.VolumeName(filepath.FromSlash(name)) != "" {
+		return "", fmt.Errorf("illegal path in archive: %s", name)
+	}
+	for _, part := range strings.Split(name, "/") {
+		if part == ".." {
+			return "", fmt.Errorf("illegal path in archive: %s", name)
+		}
+	}
+
+	target := filepath.Join(x.destDir, filepath.FromSlash(path.Clean(name)))
+	rel, err := filepath.Rel(x.destDir, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+		return "", fmt.Errorf("illegal path in archive: %s", name)
+	}
+	return target, nil
+}
+
+func (x *extractor) mkdir(name string) (string, error) {
+	target, err := x.targetPath(name)
+	if err != nil {
+		return "", err
+	}
+	return target, os.MkdirAll(target, 0755)
+}
+
+func (x *extractor) writeFile(name string, r io.Reader) error {
+	if x.opts.MaxEntries > 0 && len(x.files) >= x.opts.MaxEntries {
+		return fmt.Errorf("too many files in archive (max %d)", x.opts.MaxEntries)
+	}
+
+	target, err := x.targetPath(name)
+	if err != nil {
+		return err
+	}
+	if target == x.destDir {
+		return fmt.Errorf("illegal path in archive: %s", name)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	x.files = append(x.files, target)
+
+	// 헤더의 크기를 믿지 않고 실제로 쓴 크기로 제한
+	if x.opts.MaxTotalSize > 0 {
+		remaining := x.opts.MaxTotalSize - x.written
+		n, err := io.Copy(out, io.LimitReader(r, remaining+1))
+		x.written += n
+		out.Close()
+		if err != nil {
+			return err
+		}
+		if x.written > x.opts.MaxTotalSize {
+			return fmt.Errorf("archive exceeds max size (%d bytes)", x.opts.MaxTotalSize)
+		}
+		return nil
+	}
+
+	n, err := io.Copy(out, r)
+	x.written += n
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTestZip(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExtractArchive(t *testing.T) {
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "ok.zip")
+	writeTestZip(t, archivePath, map[string]string{"a.txt": "a", "sub/b.txt": "b"})
+
+	dest := filepath.Join(dir, "ok")
+	os.Mkdir(dest, 0755)
+	files, err := ExtractArchive(archivePath, ArchiveZip, dest, ExtractOptions{})
+	if err != nil {
+		t.Fatalf("ExtractArchive failed: %v", err)
+	}
+	if len(files) != 2 {
+		t.Errorf("expected 2 files, got %v", files)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dest, "sub", "b.txt")); string(data) != "b" {
+		t.Errorf("unexpected content: %q", data)
+	}
+}
+
+func TestExtractArchiveGuards(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		opts  ExtractOptions
+		err   string
+	}{
+		{"zip slip", map[string]string{"../evil.txt": "x"}, ExtractOptions{}, "illegal path"},
+		{"absolute path", map[string]string{"/etc/evil.txt": "x"}, ExtractOptions{}, "illegal path"},
+		{"max size", map[string]string{"big.txt": strings.Repeat("a", 100)}, ExtractOptions{MaxTotalSize: 10}, "max size"},
+		{"max entries", map[string]string{"a": "", "b": ""}, ExtractOptions{MaxEntries: 1}, "too many files"},
+		{"max ratio", map[string]string{"zeros.txt": string(bytes.Repeat([]byte{0}, 1<<20))}, ExtractOptions{MaxRatio: 100}, "ratio"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			archivePath := filepath.Join(dir, "bad.zip")
+			writeTestZip(t, archivePath, tc.files)
+
+			dest := filepath.Join(dir, "out")
+			os.Mkdir(dest, 0755)
+			_, err := ExtractArchive(archivePath, ArchiveZip, dest, tc.opts)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+			if _, statErr := os.Stat(filepath.Join(dir, "evil.txt")); statErr == nil {
+				t.Errorf("file written outside destination")
+			}
+		})
+	}
+}
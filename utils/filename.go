@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 파일명 비교 규칙 - 모든 플러그인이 같은 규칙으로 파일명과 확장자를 비교한다.
+// macOS는 한글 파일명을 NFD로, Windows는 NFC로 저장하므로 NFC로 맞춰서 비교하고
+// 확장자는 대소문자를 구분하지 않는다.
+
+// NormalizeName: 비교용 파일명 (NFC)
+func NormalizeName(name string) string {
+	return norm.NFC.String(name)
+}
+
+// NormalizeExt: 비교용 확장자 (점 제외, 소문자, NFC) - "PDF", ".pdf" -> "pdf"
+func NormalizeExt(ext string) string {
+	return strings.ToLower(NormalizeName(strings.TrimPrefix(ext, ".")))
+}
+
+// MatchExtension: ext가 extensions 목록에 있는지 확인한다. 목록이 비어 있으면 모두 허용한다.
+// ext와 목록 모두 점 유무, 대소문자와 관계 없이 비교한다.
+func MatchExtension(ext string, extensions []string) bool {
+	if len(extensions) == 0 {
+		return true
+	}
+
+	ext = NormalizeExt(ext)
+	for _, allowed := range extensions {
+		if NormalizeExt(allowed) == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// HasExtension: 파일명의 확장자가 extensions 목록에 있는지 확인한다.
+func HasExtension(filename string, extensions []string) bool {
+	return MatchExtension(filepath.Ext(filename), extensions)
+}
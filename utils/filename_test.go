@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestFilenameMatching(t *testing.T) {
+	nfc := "퀴즈_1.pdf"
+	nfd := norm.NFD.String(nfc)
+
+	if nfc == nfd {
+		t.Fatal("test setup: NFD string should differ from NFC")
+	}
+	if NormalizeName(nfc) != NormalizeName(nfd) {
+		t.Errorf("expected NFC and NFD names to match")
+	}
+
+	tests := []struct {
+		filename   string
+		extensions []string
+		expected   bool
+	}{
+		{"REPORT.PDF", []string{"pdf"}, true},
+		{"report.pdf", []string{"PDF"}, true},
+		{"report.pdf", []string{".pdf"}, true},
+		{"report.txt", []string{"pdf"}, false},
+		{"report", []string{"pdf"}, false},
+		{"report.anything", nil, true},
+	}
+
+	for _, tc := range tests {
+		if got := HasExtension(tc.filename, tc.extensions); got != tc.expected {
+			t.Errorf("HasExtension(%q, %v): expected %t, got %t", tc.filename, tc.extensions, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// DefaultManifestName: sha256sum -c로도 확인할 수 있는 형식의 기본 파일명
+const DefaultManifestName = "SHA256SUMS"
+
+// ManifestReport: 매니페스트와 현재 파일 비교 결과 (매니페스트 폴더 기준 상대 경로)
+type ManifestReport struct {
+	ManifestPath string
+	OK           int
+	Modified     []string
+	Missing      []string
+	New          []string
+}
+
+// Clean: 변경, 누락, 새 파일이 모두 없는지 확인
+func (r ManifestReport) Clean() bool {
+	return len(r.Modified) == 0 && len(r.Missing) == 0 && len(r.New) == 0
+}
+
+// WriteManifest: dir 아래 모든 파일의 SHA-256을 dir/name에 "해시  상대경로" 형식으로 쓴다.
+// 같은 이름의 매니페스트 파일은 포함하지 않는다. 기록한 파일 수를 반환한다.
+func WriteManifest(dir, name string) (int, error) {
+	files, err := manifestFiles(dir, name)
+	if err != nil {
+		return 0, err
+	}
+
+	var b strings.Builder
+	for _, rel := range files {
+		hash, err := HashFile(filepath.Join(dir, filepath.FromSlash(rel)), 0)
+		if err != nil {
+			return 0, err
+		}
+		fmt.Fprintf(&b, "%s  %s\n", hash, rel)
+	}
+
+	// 임시 파일에 쓴 뒤 교체 - 실패해도 이전 매니페스트가 남는다
+	manifestPath := filepath.Join(dir, name)
+	tempPath := manifestPath + ".fm-manifest"
+	if err := os.WriteFile(tempPath, []byte(b.String()), 0644); err != nil {
+		os.Remove(tempPath)
+		return 0, err
+	}
+	if err := os.Rename(tempPath, manifestPath); err != nil {
+		os.Remove(tempPath)
+		return 0, err
+	}
+	return len(files), nil
+}
+
+// ReadManifest: 매니페스트의 상대경로 -> 해시 목록
+func ReadManifest(manifestPath string) (map[string]string, error) {
+	file, err := os.Open(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	hashes := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" {
+			continue
+		}
+
+		// "해시  경로" 또는 바이너리 모드 "해시 *경로"
+		hash, rel, ok := strings.Cut(text, " ")
+		if !ok || len(hash) != 64 || len(rel) < 2 || (rel[0] != ' ' && rel[0] != '*') {
+			return nil, fmt.Errorf("%s:%d: invalid manifest line", manifestPath, line)
+		}
+		hashes[rel[1:]] = strings.ToLower(hash)
+	}
+	return hashes, scanner.Err()
+}
+
+// VerifyManifest: 매니페스트가 있는 폴더의 현재 파일과 비교한다.
+func VerifyManifest(manifestPath string) (ManifestReport, error) {
+	report := ManifestReport{ManifestPath: manifestPath}
+
+	expected, err := ReadManifest(manifestPath)
+	if err != nil {
+		return report, err
+	}
+
+	dir, name := filepath.Dir(manifestPath), filepath.Base(manifestPath)
+	files, err := manifestFiles(dir, name)
+	if err != nil {
+		return report, err
+	}
+
+	present := make(map[string]bool, len(files))
+	for _, rel := range files {
+		present[rel] = true
+		hash, ok := expected[rel]
+		if !ok {
+			report.New = append(report.New, rel)
+			continue
+		}
+
+		current, err := HashFile(filepath.Join(dir, filepath.FromSlash(rel)), 0)
+		if err != nil {
+			return report, err
+		}
+		if current == hash {
+			report.OK++
+		} else {
+			report.Modified = append(report.Modified, rel)
+		}
+	}
+
+	for rel := range expected {
+		if !present[rel] {
+			report.Missing = append(report.Missing, rel)
+		}
+	}
+	slices.Sort(report.Missing)
+
+	return report, nil
+}
+
+// FindManifests: root 아래의 name 매니페스트 파일 경로 목록
+func FindManifests(root, name string) ([]string, error) {
+	var manifests []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == name {
+			manifests = append(manifests, path)
+		}
+		return nil
+	})
+	return manifests, err
+}
+
+// manifestFiles: dir 아래 파일의 상대 경로 (/ 구분, 정렬). name 매니페스트 파일은 제외
+func manifestFiles(dir, name string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() == name || strings.HasSuffix(d.Name(), ".fm-manifest") {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	slices.Sort(files)
+	return files, err
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteAndVerifyManifest(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "sub"), 0755)
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644)
+	os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("b"), 0644)
+	os.WriteFile(filepath.Join(dir, "c.txt"), []byte("c"), 0644)
+
+	count, err := WriteManifest(dir, DefaultManifestName)
+	if err != nil || count != 3 {
+		t.Fatalf("WriteManifest: %d, %v", count, err)
+	}
+
+	manifestPath := filepath.Join(dir, DefaultManifestName)
+	report, err := VerifyManifest(manifestPath)
+	if err != nil || !report.Clean() || report.OK != 3 {
+		t.Fatalf("expected clean report, got %+v, %v", report, err)
+	}
+
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("changed"), 0644)
+	os.Remove(filepath.Join(dir, "c.txt"))
+	os.WriteFile(filepath.Join(dir, "sub", "new.txt"), []byte("new"), 0644)
+
+	report, err = VerifyManifest(manifestPath)
+	if err != nil {
+		t.Fatalf("VerifyManifest failed: %v", err)
+	}
+	if len(report.Modified) != 1 || report.Modified[0] != "a.txt" ||
+		len(report.Missing) != 1 || report.Missing[0] != "c.txt" ||
+		len(report.New) != 1 || report.New[0] != "sub/new.txt" || report.OK != 1 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+
+	manifests, err := FindManifests(dir, DefaultManifestName)
+	if err != nil || len(manifests) != 1 {
+		t.Errorf("FindManifests: %v, %v", manifests, err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/yek-j/filemanager/config"
@@ -12,12 +13,16 @@ import (
 
 // ScanReport: 설정을 검증하여 작업이 가능한 상태임을 확인할 수 있는 구조체
 type ScanReport struct {
-	RootExists     bool
-	TargetFolders  map[string]bool  // 폴더명: 존재여부
-	FoldersByDepth map[int][]string // 깊이별 폴더 목록
-	FilesByExt     map[string]int   // 확장자별 개수
-	TotalFiles     int
-	ReadyToProcess bool
+	RootExists     bool             `json:"root_exists"`
+	TargetFolders  map[string]bool  `json:"target_folders"`   // 폴더명: 존재여부
+	FoldersByDepth map[int][]string `json:"folders_by_depth"` // 깊이별 폴더 목록
+	FilesByExt     map[string]int   `json:"files_by_ext"`     // 확장자별 개수
+	TotalFiles     int              `json:"total_files"`
+	ReadyToProcess bool             `json:"ready_to_process"`
+
+	ExpandedFolders map[string][]string `json:"expanded_folders,omitempty"` // 패턴: 확장된 폴더 목록
+	DuplicateBytes  int64               `json:"duplicate_bytes,omitempty"`  // 중복 파일 크기 추정치 (dedupe 플러그인이 있을 때만)
+	Warnings        []string            `json:"warnings,omitempty"`         // 작업은 가능하지만 확인이 필요한 설정
 }
 
 // ScanFiles는 Config에서 가져온 폴더의 유효성을 검증하고 총 작업 파일 수 확인
@@ -46,8 +51,21 @@ func ScanFiles(cfg *config.Config) (*ScanReport, error) {
 
 	scanReport.RootExists = true // root 파일 존재 확인
 
+	// TargetFolders의 glob/정규식 패턴 확장
+	targetFolders, expansions, err := ExpandTargetFolders(cfg.SourcePath, cfg.TargetFolders)
+	if err != nil {
+		return scanReport, err
+	}
+	scanReport.ExpandedFolders = expansions
+	for pattern, matches := range expansions {
+		if len(matches) == 0 {
+			scanReport.Warnings = append(scanReport.Warnings,
+				fmt.Sprintf("target folder pattern %q matched no folders", pattern))
+		}
+	}
+
 	// TargetFolders 존재 여부 확인
-	for _, targetFolder := range cfg.TargetFolders {
+	for _, targetFolder := range targetFolders {
 		// 전체 경로 생성
 		targetPath := filepath.Join(cfg.SourcePath, targetFolder)
 
@@ -59,11 +77,16 @@ func ScanFiles(cfg *config.Config) (*ScanReport, error) {
 		}
 	}
 
+	var scannedFiles []string // 중복 크기 추정용
+
 	// FoldersByDepth 깊이별 폴더 목록 확인
 	// FilesByExt 최종 TargetDepth에서 확장자별 파일 수 확인
 	// TotalFiles 총 파일 수 확인
 	if cfg.SelectiveCopy {
-		for _, targetFolder := range cfg.TargetFolders {
+		for _, targetFolder := range targetFolders {
+			if !scanReport.TargetFolders[targetFolder] {
+				continue // 없는 폴더는 스캔하지 않음
+			}
 			targetPath := filepath.Join(cfg.SourcePath, targetFolder)
 
 			err = filepath.WalkDir(targetPath, func(path string, d fs.DirEntry, err error) error {
@@ -90,6 +113,9 @@ func ScanFiles(cfg *config.Config) (*ScanReport, error) {
 						scanReport.FilesByExt[ext]++
 						scanReport.TotalFiles++
 					}
+					if d.Type().IsRegular() {
+						scannedFiles = append(scannedFiles, path)
+					}
 				}
 				return nil
 			})
@@ -121,6 +147,9 @@ func ScanFiles(cfg *config.Config) (*ScanReport, error) {
 					scanReport.FilesByExt[ext]++
 					scanReport.TotalFiles++
 				}
+				if d.Type().IsRegular() {
+					scannedFiles = append(scannedFiles, path)
+				}
 			}
 			return nil
 		})
@@ -130,6 +159,23 @@ func ScanFiles(cfg *config.Config) (*ScanReport, error) {
 		return scanReport, fmt.Errorf("failed to scan directory structure: %v", err)
 	}
 
+	// 중복 파일 크기 추정 (크기 + 앞부분 해시 기준) - 추정치이므로 읽을 수 없는 파일은 건너뜀
+	// 파일을 읽어야 하므로 dedupe 플러그인이 있을 때만 계산
+	if cfg.HasPlugin("dedupe") {
+		duplicateBytes, skipped := EstimateDuplicateBytes(scannedFiles)
+		scanReport.DuplicateBytes = duplicateBytes
+		if len(skipped) > 0 {
+			scanReport.Warnings = append(scanReport.Warnings,
+				fmt.Sprintf("duplicate estimate skipped %d unreadable files (e.g. %s)", len(skipped), skipped[0]))
+		}
+	}
+
+	pluginWarnings, err := checkPluginFolders(cfg, targetFolders)
+	if err != nil {
+		return scanReport, err
+	}
+	scanReport.Warnings = append(scanReport.Warnings, pluginWarnings...)
+
 	// ReadyToProcess 위에 ROOT 폴더, Target 폴더 모두 존재한다면 작업 준비 완료
 	existingCount := 0
 	for _, exists := range scanReport.TargetFolders {
@@ -138,7 +184,10 @@ func ScanFiles(cfg *config.Config) (*ScanReport, error) {
 		}
 	}
 
-	if scanReport.RootExists && existingCount == len(cfg.TargetFolders) {
+	// target_folders를 지정했는데 패턴이 모두 확장되지 않으면 복사할 폴더가 없으므로 준비되지 않은 것으로 본다
+	if len(cfg.TargetFolders) > 0 && len(targetFolders) == 0 {
+		scanReport.ReadyToProcess = false
+	} else if scanReport.RootExists && existingCount == len(scanReport.TargetFolders) {
 		scanReport.ReadyToProcess = true
 	} else {
 		scanReport.ReadyToProcess = false
@@ -147,6 +196,41 @@ func ScanFiles(cfg *config.Config) (*ScanReport, error) {
 	return scanReport, nil
 }
 
+// checkPluginFolders: 플러그인이 사용하는 폴더가 스캔/복사 대상인지 확인한다.
+// targetFolders: 패턴이 확장된 최상위 target_folders
+func checkPluginFolders(cfg *config.Config, targetFolders []string) ([]string, error) {
+	var warnings []string
+
+	for _, plugin := range cfg.Plugin {
+		folders, expansions, err := ExpandTargetFolders(cfg.SourcePath, plugin.TargetFolders())
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s: %v", plugin.Name, err)
+		}
+
+		for pattern, matches := range expansions {
+			if len(matches) == 0 {
+				warnings = append(warnings, fmt.Sprintf(
+					"plugin %s: folder pattern %q matched no folders", plugin.Name, pattern))
+			}
+		}
+
+		for _, folder := range folders {
+			if cfg.SelectiveCopy && !slices.Contains(targetFolders, folder) {
+				warnings = append(warnings, fmt.Sprintf(
+					"plugin %s: folder %q is not in target_folders and will not be copied", plugin.Name, folder))
+				continue
+			}
+
+			if _, err := os.Stat(filepath.Join(cfg.SourcePath, folder)); err != nil {
+				warnings = append(warnings, fmt.Sprintf(
+					"plugin %s: folder %q does not exist in source_path", plugin.Name, folder))
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
 // IsWorkPathEmpty: 폴더가 없으면 true, 폴더가 있지만 비어있으면 true,
 // 폴더에 파일/폴더 있으면 false 반환
 func IsWorkPathEmpty(workPath string) bool {
@@ -169,25 +253,66 @@ func GetTargetDirs(basePath string, depth int) ([]string) {
 
 	// 'depth - 1' 반복으로 최종 폴더 찾기
 	for i := 1; i < depth; i++ {
-		nextDirs := []string{}
+		currentDirs = subDirs(currentDirs)
+	}
 
-		for _, dir := range currentDirs {
-			// dir의 하위 폴더들 읽기
-			entries, err := os.ReadDir(dir)
+	return currentDirs
+}
 
-			if err != nil {
-				continue // 읽을 수 없다면 스킵
-			}
+// GetTargetDirsInRange: basePath 기준 minDepth ~ maxDepth 깊이의 폴더를 모두 반환
+// 깊이 규칙은 GetTargetDirs와 같다 (depth 1 = basePath)
+func GetTargetDirsInRange(basePath string, minDepth, maxDepth int) []string {
+	var result []string
+	currentDirs := []string{basePath}
 
-			for _, entry := range entries {
-				if entry.IsDir() {
-					nextDirs = append(nextDirs, filepath.Join(dir, entry.Name()))
-				}
-			}
+	for depth := 1; depth <= maxDepth && len(currentDirs) > 0; depth++ {
+		if depth >= minDepth {
+			result = append(result, currentDirs...)
 		}
 
-		currentDirs = nextDirs
+		if depth == maxDepth {
+			break
+		}
+		currentDirs = subDirs(currentDirs)
 	}
 
-	return currentDirs
+	return result
+}
+
+// GetLeafDirs: basePath 아래에서 하위 폴더가 없는 폴더를 모두 반환
+func GetLeafDirs(basePath string) []string {
+	var leafDirs []string
+
+	filepath.WalkDir(basePath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil // 읽을 수 없다면 스킵
+		}
+
+		if len(subDirs([]string{path})) == 0 {
+			leafDirs = append(leafDirs, path)
+		}
+		return nil
+	})
+
+	return leafDirs
+}
+
+// subDirs: dirs 각각의 바로 아래 폴더 목록
+func subDirs(dirs []string) []string {
+	nextDirs := []string{}
+
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue // 읽을 수 없다면 스킵
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() {
+				nextDirs = append(nextDirs, filepath.Join(dir, entry.Name()))
+			}
+		}
+	}
+
+	return nextDirs
 }
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/yek-j/filemanager/config"
+)
+
+func TestGetTargetDirsByDepth(t *testing.T) {
+	base := t.TempDir()
+	for _, dir := range []string{"f1/1111", "f1/2222", "f2"} {
+		os.MkdirAll(filepath.Join(base, dir), 0755)
+	}
+
+	rel := func(dirs []string) string {
+		names := []string{}
+		for _, dir := range dirs {
+			name, _ := filepath.Rel(base, dir)
+			names = append(names, filepath.ToSlash(name))
+		}
+		sort.Strings(names)
+		return strings.Join(names, ",")
+	}
+
+	if got := rel(GetTargetDirs(base, 3)); got != "f1/1111,f1/2222" {
+		t.Errorf("GetTargetDirs depth 3: got %s", got)
+	}
+
+	if got := rel(GetTargetDirsInRange(base, 2, 3)); got != "f1,f1/1111,f1/2222,f2" {
+		t.Errorf("GetTargetDirsInRange 2~3: got %s", got)
+	}
+
+	if got := rel(GetTargetDirsInRange(base, 1, 1)); got != "." {
+		t.Errorf("GetTargetDirsInRange 1~1: got %s", got)
+	}
+
+	// f2는 깊이 2에서 끝나는 leaf 폴더
+	if got := rel(GetLeafDirs(base)); got != "f1/1111,f1/2222,f2" {
+		t.Errorf("GetLeafDirs: got %s", got)
+	}
+}
+
+func TestScanFilesDuplicateEstimate(t *testing.T) {
+	source := t.TempDir()
+	os.MkdirAll(filepath.Join(source, "paper"), 0755)
+	os.WriteFile(filepath.Join(source, "paper", "a.txt"), []byte("same"), 0644)
+	os.WriteFile(filepath.Join(source, "paper", "b.txt"), []byte("same"), 0644)
+
+	cfg := &config.Config{SourcePath: source, TargetFolders: []string{"paper"}, TargetDepth: 1}
+	report, err := ScanFiles(cfg)
+	if err != nil || report.DuplicateBytes != 0 {
+		t.Errorf("expected no estimate without dedupe plugin, got %d (%v)", report.DuplicateBytes, err)
+	}
+
+	cfg.Plugin = []config.PluginConfig{{Name: "dedupe"}}
+	report, err = ScanFiles(cfg)
+	if err != nil || report.DuplicateBytes != 4 {
+		t.Errorf("expected 4 duplicate bytes with dedupe plugin, got %d (%v)", report.DuplicateBytes, err)
+	}
+}
+
+func TestScanFilesNotReadyWhenPatternsMatchNothing(t *testing.T) {
+	source := t.TempDir()
+	os.MkdirAll(filepath.Join(source, "paper"), 0755)
+
+	cfg := &config.Config{SourcePath: source, TargetFolders: []string{"report-*", "re:^20\\d{2}$"}, TargetDepth: 1}
+	report, err := ScanFiles(cfg)
+	if err != nil {
+		t.Fatalf("ScanFiles failed: %v", err)
+	}
+	if report.ReadyToProcess {
+		t.Errorf("expected not ready when target_folders patterns match no folders")
+	}
+
+	cfg.TargetFolders = []string{"pap*"}
+	if report, _ := ScanFiles(cfg); !report.ReadyToProcess {
+		t.Errorf("expected ready when a pattern matches, got %+v", report)
+	}
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RenamePair: 이름 변경 한 건 (전체 경로)
+type RenamePair struct {
+	From string
+	To   string
+}
+
+// PlanRenames: 이름 변경 목록 전체를 검사해 충돌 없는 변경만 반환한다.
+// 같은 대상으로 가는 변경, 이미 존재하는 파일(목록 안에서 옮겨지는 파일은 제외)과 겹치는 변경은
+// 충돌로 분류하며, 이름이 같은 변경(From == To)은 제외한다.
+func PlanRenames(pairs []RenamePair) (valid []RenamePair, conflicts []string) {
+	skipped := make(map[int]bool)
+
+	for i, pair := range pairs {
+		if pair.From == pair.To {
+			skipped[i] = true
+		}
+	}
+
+	// 충돌로 건너뛴 파일은 제자리에 남으므로 다른 변경의 충돌이 될 수 있어 반복 확인
+	for changed := true; changed; {
+		changed = false
+
+		movingAway := make(map[string]bool) // 이번 변경으로 비워지는 경로
+		targets := make(map[string]int)     // 대상 경로별 변경 수
+		for i, pair := range pairs {
+			if !skipped[i] {
+				movingAway[pair.From] = true
+				targets[pair.To]++
+			}
+		}
+
+		for i, pair := range pairs {
+			if skipped[i] {
+				continue
+			}
+
+			reason := ""
+			if targets[pair.To] > 1 {
+				reason = "multiple files renamed to the same name"
+			} else if toInfo, err := os.Lstat(pair.To); err == nil && !movingAway[pair.To] && !isSameFileRename(pair, toInfo) {
+				reason = "target already exists"
+			}
+
+			if reason != "" {
+				conflicts = append(conflicts, fmt.Sprintf("%s -> %s: %s", pair.From, pair.To, reason))
+				skipped[i] = true
+				changed = true
+			}
+		}
+	}
+
+	for i, pair := range pairs {
+		if !skipped[i] {
+			valid = append(valid, pair)
+		}
+	}
+	return valid, conflicts
+}
+
+// isSameFileRename: 대소문자나 유니코드 정규화를 구분하지 않는 파일 시스템(APFS, NTFS 등)에서
+// 같은 폴더 안의 REPORT.PDF -> REPORT.pdf, NFD -> NFC 변경은 대상이 원본 자신으로 보이므로 충돌이 아니다.
+// 대상 이름이 폴더에 그대로 있으면 같은 파일의 하드링크이므로 충돌로 본다.
+func isSameFileRename(pair RenamePair, toInfo os.FileInfo) bool {
+	dir := filepath.Dir(pair.From)
+	if dir != filepath.Dir(pair.To) {
+		return false
+	}
+	fromInfo, err := os.Lstat(pair.From)
+	if err != nil || !os.SameFile(fromInfo, toInfo) {
+		return false
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if entry.Name() == filepath.Base(pair.To) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyRenames: PlanRenames로 검사한 변경을 실행하고 완료된 변경을 반환한다.
+// 서로의 이름을 바꾸는 경우(a->b, b->a)를 위해 임시 이름을 거쳐 두 단계로 변경한다.
+// 중간에 실패하면 남은 임시 파일은 원래 이름으로 되돌리며, 반환된 변경은 이미 적용된 것이다.
+func ApplyRenames(pairs []RenamePair) ([]RenamePair, error) {
+	if len(pairs) == 1 {
+		if err := os.Rename(pairs[0].From, pairs[0].To); err != nil {
+			return nil, err
+		}
+		return pairs, nil
+	}
+
+	temps := make([]string, len(pairs))
+	for i, pair := range pairs {
+		temps[i] = fmt.Sprintf("%s.fm-rename-%d", pair.From, i)
+		if err := os.Rename(pair.From, temps[i]); err != nil {
+			// 이미 옮긴 파일은 원래 이름으로 되돌림
+			for j := 0; j < i; j++ {
+				os.Rename(temps[j], pairs[j].From)
+			}
+			return nil, fmt.Errorf("rename failed: %v", err)
+		}
+	}
+
+	for i, pair := range pairs {
+		if err := os.Rename(temps[i], pair.To); err != nil {
+			stranded := rollbackTemps(pairs[i:], temps[i:])
+			err = fmt.Errorf("rename failed: %s -> %s: %v (%d of %d renames completed)",
+				pair.From, pair.To, err, i, len(pairs))
+			if len(stranded) > 0 {
+				err = fmt.Errorf("%v; left as temporary files: %s", err, strings.Join(stranded, ", "))
+			}
+			return pairs[:i], err
+		}
+	}
+	return pairs, nil
+}
+
+// rollbackTemps: 아직 적용하지 못한 임시 파일을 원래 이름으로 되돌린다.
+// 원래 이름을 이미 완료된 다른 변경이 차지했으면 덮어쓰지 않고 임시 이름으로 남기며, 그 경로를 반환한다.
+func rollbackTemps(pairs []RenamePair, temps []string) (stranded []string) {
+	for i, pair := range pairs {
+		if _, err := os.Lstat(pair.From); err == nil {
+			stranded = append(stranded, temps[i])
+			continue
+		}
+		if err := os.Rename(temps[i], pair.From); err != nil {
+			stranded = append(stranded, temps[i])
+		}
+	}
+	return stranded
+}
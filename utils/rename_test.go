@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestPlanAndApplyRenames(t *testing.T) {
+	dir := t.TempDir()
+	path := func(name string) string { return filepath.Join(dir, name) }
+	for _, name := range []string{"a.txt", "b.txt", "c.txt", "d.txt", "taken.txt"} {
+		os.WriteFile(path(name), []byte(name), 0644)
+	}
+
+	pairs := []RenamePair{
+		{path("a.txt"), path("b.txt")}, // a <-> b 교환
+		{path("b.txt"), path("a.txt")},
+		{path("c.txt"), path("taken.txt")}, // 이미 존재
+		{path("d.txt"), path("d.txt")},     // 변경 없음
+	}
+
+	valid, conflicts := PlanRenames(pairs)
+	if len(valid) != 2 || len(conflicts) != 1 {
+		t.Fatalf("expected 2 valid renames and 1 conflict, got %v, %v", valid, conflicts)
+	}
+
+	if _, err := ApplyRenames(valid); err != nil {
+		t.Fatalf("ApplyRenames failed: %v", err)
+	}
+
+	if data, _ := os.ReadFile(path("b.txt")); string(data) != "a.txt" {
+		t.Errorf("expected b.txt to contain a.txt, got %q", data)
+	}
+	if data, _ := os.ReadFile(path("a.txt")); string(data) != "b.txt" {
+		t.Errorf("expected a.txt to contain b.txt, got %q", data)
+	}
+}
+
+func TestPlanRenamesCascadingConflict(t *testing.T) {
+	dir := t.TempDir()
+	path := func(name string) string { return filepath.Join(dir, name) }
+	for _, name := range []string{"x.txt", "y.txt", "z.txt"} {
+		os.WriteFile(path(name), nil, 0644)
+	}
+
+	// y는 z와 충돌해 제자리에 남으므로 x -> y도 충돌이다
+	valid, conflicts := PlanRenames([]RenamePair{
+		{path("x.txt"), path("y.txt")},
+		{path("y.txt"), path("z.txt")},
+	})
+	if len(valid) != 0 || len(conflicts) != 2 {
+		t.Errorf("expected 2 conflicts, got %v, %v", valid, conflicts)
+	}
+}
+
+func TestPlanRenamesSameFile(t *testing.T) {
+	dir := t.TempDir()
+	nfd := filepath.Join(dir, norm.NFD.String("퀴즈.pdf"))
+	nfc := filepath.Join(dir, "퀴즈.pdf")
+	os.WriteFile(nfd, nil, 0644)
+
+	// APFS/NTFS에서는 NFC 이름으로 조회해도 원본(NFD)이 나온다 - 조회 결과를 원본 정보로 대신한다
+	fromInfo, _ := os.Lstat(nfd)
+	if !isSameFileRename(RenamePair{nfd, nfc}, fromInfo) {
+		t.Errorf("expected normalization-only rename of the same file to be allowed")
+	}
+
+	// 같은 폴더의 하드링크는 다른 이름이 실제로 있으므로 충돌
+	link := filepath.Join(dir, "link.pdf")
+	os.Link(nfd, link)
+	if _, conflicts := PlanRenames([]RenamePair{{nfd, link}}); len(conflicts) != 1 {
+		t.Errorf("expected hardlink target to conflict, got %v", conflicts)
+	}
+}
+
+func TestApplyRenamesRollback(t *testing.T) {
+	dir := t.TempDir()
+	path := func(name string) string { return filepath.Join(dir, name) }
+	for _, name := range []string{"a.txt", "b.txt", "c.txt", "d.txt"} {
+		os.WriteFile(path(name), []byte(name), 0644)
+	}
+
+	// 없는 폴더로 가는 변경은 두 번째 단계에서 실패한다
+	pairs := []RenamePair{
+		{path("a.txt"), path("e.txt")},
+		{path("b.txt"), path("missing/b.txt")},
+		{path("c.txt"), path("f.txt")},
+		{path("d.txt"), path("a.txt")}, // 완료된 변경이 비운 이름
+	}
+
+	done, err := ApplyRenames(pairs)
+	if err == nil {
+		t.Fatalf("expected ApplyRenames to fail")
+	}
+	if len(done) != 1 || done[0] != pairs[0] {
+		t.Errorf("expected only a.txt -> e.txt completed, got %v", done)
+	}
+	for _, name := range []string{"e.txt", "b.txt", "c.txt", "d.txt"} {
+		if _, err := os.Stat(path(name)); err != nil {
+			t.Errorf("expected %s after rollback: %v", name, err)
+		}
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 4 {
+		t.Errorf("expected no temporary files left, got %d entries", len(entries))
+	}
+}
@@ -0,0 +1,54 @@
+package utils
+
+import "testing"
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"512", 512},
+		{"512B", 512},
+		{"10KB", 10 << 10},
+		{"10 kb", 10 << 10},
+		{"1.5MB", 3 << 19},
+		{"2GiB", 2 << 30},
+		{"1T", 1 << 40},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseSize(tc.input)
+		if err != nil {
+			t.Errorf("ParseSize(%q) failed: %v", tc.input, err)
+			continue
+		}
+		if got != tc.expected {
+			t.Errorf("ParseSize(%q): expected %d, got %d", tc.input, tc.expected, got)
+		}
+	}
+
+	for _, input := range []string{"", "MB", "10XB", "1.2.3KB", "-5MB", "99999999TB", "8388608TB"} {
+		if _, err := ParseSize(input); err == nil {
+			t.Errorf("ParseSize(%q): expected error", input)
+		}
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		size     int64
+		expected string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1536, "1.5 KB"},
+		{10 << 20, "10.0 MB"},
+		{3 << 30, "3.0 GB"},
+	}
+
+	for _, tc := range tests {
+		if got := FormatSize(tc.size); got != tc.expected {
+			t.Errorf("FormatSize(%d): expected %q, got %q", tc.size, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+)
+
+// FileKind: 파일 내용으로 판별한 형식
+type FileKind struct {
+	Ext        string   // 확장자가 없을 때 붙일 확장자 (점 제외)
+	Compatible []string // 이 형식으로 인정하는 다른 확장자 (예: zip 기반 docx, hwpx)
+
+	// 여러 형식이 같이 쓰는 컨테이너 시그니처 (zip, OLE, ftyp 등)
+	// Compatible에 없는 확장자라도 틀렸다고 단정할 수 없으므로 확장자가 없을 때만 사용한다
+	Container bool
+}
+
+// Matches: ext(점 포함 가능)가 이 형식의 확장자인지 확인 (대소문자 무시)
+func (k FileKind) Matches(ext string) bool {
+	ext = strings.TrimPrefix(NormalizeExt(ext), ".")
+	return ext == k.Ext || slices.Contains(k.Compatible, ext)
+}
+
+type magicNumber struct {
+	offset int
+	magic  []byte
+	kind   FileKind
+	check  func(head []byte) bool // 매직 넘버가 짧을 때 추가로 확인할 헤더 조건 (nil이면 생략)
+}
+
+// 자주 쓰는 형식의 매직 넘버 - http.DetectContentType보다 먼저 확인
+var magicNumbers = []magicNumber{
+	{0, []byte("%PDF-"), FileKind{Ext: "pdf"}, nil},
+	{0, []byte("\x89PNG\r\n\x1a\n"), FileKind{Ext: "png"}, nil},
+	{0, []byte{0xFF, 0xD8, 0xFF}, FileKind{Ext: "jpg", Compatible: []string{"jpeg", "jpe"}}, nil},
+	{0, []byte("GIF8"), FileKind{Ext: "gif"}, nil},
+	{0, []byte("BM"), FileKind{Ext: "bmp"}, isBMPHeader},
+	{8, []byte("WEBP"), FileKind{Ext: "webp"}, isRIFF},
+	{8, []byte("WAVE"), FileKind{Ext: "wav"}, isRIFF},
+	{8, []byte("AVI "), FileKind{Ext: "avi"}, isRIFF},
+	{4, []byte("ftypqt"), FileKind{Ext: "mov"}, nil},
+	{4, []byte("ftypM4A"), FileKind{Ext: "m4a"}, nil},
+	// ISO 미디어 (avif, m4b, 3g2 등 brand가 많음)
+	{4, []byte("ftyp"), FileKind{Ext: "mp4", Compatible: []string{"m4v", "mov", "m4a", "3gp", "heic"}, Container: true}, nil},
+	{0, []byte("ID3"), FileKind{Ext: "mp3"}, nil},
+	{0, []byte("fLaC"), FileKind{Ext: "flac"}, nil},
+	{0, []byte("OggS"), FileKind{Ext: "ogg", Compatible: []string{"oga", "ogv", "opus"}}, nil},
+	{0, []byte("7z\xBC\xAF\x27\x1C"), FileKind{Ext: "7z"}, nil},
+	{0, []byte("Rar!\x1a\x07"), FileKind{Ext: "rar"}, nil},
+	{0, []byte{0x1F, 0x8B}, FileKind{Ext: "gz", Compatible: []string{"tgz"}}, nil},
+	// zip 기반 문서 형식은 모두 zip으로 인정
+	{0, []byte("PK\x03\x04"), FileKind{Ext: "zip", Compatible: []string{"docx", "xlsx", "pptx", "hwpx", "odt", "ods", "odp", "epub", "jar", "apk"}, Container: true}, nil},
+	// OLE 복합 문서 (구버전 Office, 한글)
+	{0, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}, FileKind{Ext: "doc", Compatible: []string{"xls", "ppt", "hwp", "msg"}, Container: true}, nil},
+}
+
+// isBMPHeader: BM 뒤의 예약 필드(0)와 DIB 헤더 크기까지 맞아야 BMP로 판단
+func isBMPHeader(head []byte) bool {
+	if len(head) < 18 || !bytes.Equal(head[6:10], []byte{0, 0, 0, 0}) {
+		return false
+	}
+	switch binary.LittleEndian.Uint32(head[14:18]) {
+	case 12, 40, 52, 56, 64, 108, 124:
+		return true
+	}
+	return false
+}
+
+// isRIFF: 8번째 바이트의 형식 이름은 RIFF 파일일 때만 의미가 있다
+func isRIFF(head []byte) bool {
+	return bytes.HasPrefix(head, []byte("RIFF"))
+}
+
+// http.DetectContentType 결과별 형식 (매직 넘버 표에 없는 형식)
+// html/xml 판별은 앞부분 태그만 보므로 markdown, svg, php 등과 구분할 수 없어 컨테이너로 취급한다
+var mimeKinds = map[string]FileKind{
+	"text/html":          {Ext: "html", Compatible: []string{"htm", "xhtml"}, Container: true},
+	"text/xml":           {Ext: "xml", Compatible: []string{"svg", "xhtml", "plist"}, Container: true},
+	"image/x-icon":       {Ext: "ico"},
+	"audio/midi":         {Ext: "mid", Compatible: []string{"midi"}},
+	"font/woff":          {Ext: "woff"},
+	"font/woff2":         {Ext: "woff2"},
+	"video/webm":         {Ext: "webm", Compatible: []string{"mkv"}},
+	"application/x-gzip": {Ext: "gz", Compatible: []string{"tgz"}},
+}
+
+// SniffFile: 파일 앞부분으로 형식을 판별한다. 일반 텍스트나 알 수 없는 형식이면 ok가 false
+func SniffFile(path string) (kind FileKind, ok bool, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return FileKind{}, false, err
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return FileKind{}, false, err
+	}
+	head = head[:n]
+
+	kind, ok = SniffBytes(head)
+	return kind, ok, nil
+}
+
+// SniffBytes: 파일 앞부분(최대 512바이트)으로 형식을 판별한다.
+func SniffBytes(head []byte) (FileKind, bool) {
+	for _, m := range magicNumbers {
+		if len(head) < m.offset+len(m.magic) || !bytes.Equal(head[m.offset:m.offset+len(m.magic)], m.magic) {
+			continue
+		}
+		if m.check == nil || m.check(head) {
+			return m.kind, true
+		}
+	}
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return FileKind{}, false
+	}
+	kind, ok := mimeKinds[mediaType]
+	return kind, ok
+}
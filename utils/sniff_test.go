@@ -0,0 +1,36 @@
+package utils
+
+import "testing"
+
+func TestSniffBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		head []byte
+		ext  string
+		ok   bool
+	}{
+		{"pdf", []byte("%PDF-1.7\n"), "pdf", true},
+		{"png", []byte("\x89PNG\r\n\x1a\n\x00\x00"), "png", true},
+		{"jpeg", []byte{0xFF, 0xD8, 0xFF, 0xE0}, "jpg", true},
+		{"mp4", []byte("\x00\x00\x00\x18ftypmp42"), "mp4", true},
+		{"zip", []byte("PK\x03\x04\x14\x00"), "zip", true},
+		{"html", []byte("<!DOCTYPE html><html>"), "html", true},
+		{"plain text", []byte("hello world\n"), "", false},
+		{"bmp", append([]byte("BM\x46\x00\x00\x00\x00\x00\x00\x00\x36\x00\x00\x00\x28\x00\x00\x00"), 0), "bmp", true},
+		{"text starting with BM", []byte("BMI,weight,height\n22.5,70,176\n"), "", false},
+		{"wav", []byte("RIFF\x24\x00\x00\x00WAVEfmt "), "wav", true},
+		{"WAVE without RIFF", []byte("notes:  WAVE form\n"), "", false},
+	}
+
+	for _, tc := range tests {
+		kind, ok := SniffBytes(tc.head)
+		if ok != tc.ok || kind.Ext != tc.ext {
+			t.Errorf("%s: got %+v, %v", tc.name, kind, ok)
+		}
+	}
+
+	zip, _ := SniffBytes([]byte("PK\x03\x04"))
+	if !zip.Matches(".DOCX") || zip.Matches("pdf") || !zip.Container {
+		t.Errorf("zip compatible extensions not applied: %+v", zip)
+	}
+}
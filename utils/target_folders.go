@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// regexPrefix target_folders에서 정규식 패턴을 나타내는 접두사 (예: "re:^class_\d{4}_")
+const regexPrefix = "re:"
+
+// IsFolderPattern: target_folders 항목이 glob 또는 정규식 패턴인지 확인한다.
+func IsFolderPattern(folder string) bool {
+	return strings.HasPrefix(folder, regexPrefix) || strings.ContainsAny(folder, "*?[")
+}
+
+// ExpandTargetFolders: target_folders의 패턴을 root 바로 아래 폴더 이름으로 확장한다.
+// 일반 폴더 이름은 존재 여부와 관계 없이 그대로 반환한다.
+// return: 중복 없는 폴더 이름 목록, 패턴별 확장 결과
+func ExpandTargetFolders(root string, folders []string) ([]string, map[string][]string, error) {
+	var expanded []string
+	expansions := make(map[string][]string)
+	seen := make(map[string]bool)
+
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			expanded = append(expanded, name)
+		}
+	}
+
+	var dirNames []string // root의 하위 폴더 (패턴이 있을 때만 읽음)
+	dirsLoaded := false
+
+	for _, folder := range folders {
+		if !IsFolderPattern(folder) {
+			add(folder)
+			continue
+		}
+
+		if !dirsLoaded {
+			dirNames = listDirNames(root)
+			dirsLoaded = true
+		}
+
+		matches, err := matchFolderPattern(folder, dirNames)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		expansions[folder] = matches
+		for _, match := range matches {
+			add(match)
+		}
+	}
+
+	return expanded, expansions, nil
+}
+
+func matchFolderPattern(pattern string, dirNames []string) ([]string, error) {
+	matches := []string{}
+
+	if strings.HasPrefix(pattern, regexPrefix) {
+		re, err := regexp.Compile(strings.TrimPrefix(pattern, regexPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("invalid target folder regex %q: %v", pattern, err)
+		}
+		for _, name := range dirNames {
+			if re.MatchString(name) {
+				matches = append(matches, name)
+			}
+		}
+		return matches, nil
+	}
+
+	for _, name := range dirNames {
+		matched, err := filepath.Match(pattern, name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target folder pattern %q: %v", pattern, err)
+		}
+		if matched {
+			matches = append(matches, name)
+		}
+	}
+	return matches, nil
+}
+
+// listDirNames: dir 바로 아래 폴더 이름 (정렬됨)
+func listDirNames(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil // 읽을 수 없다면 매칭 없음
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExpandTargetFolders(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"class_2024_1", "class_2024_2", "class_2023_2", "paper"} {
+		os.Mkdir(filepath.Join(root, name), 0755)
+	}
+	os.WriteFile(filepath.Join(root, "class_2024_notes.txt"), nil, 0644) // 파일은 제외
+
+	tests := []struct {
+		folders  []string
+		expected []string
+	}{
+		{[]string{"paper", "missing"}, []string{"paper", "missing"}},
+		{[]string{"class_2024_*"}, []string{"class_2024_1", "class_2024_2"}},
+		{[]string{`re:^class_\d{4}_2$`}, []string{"class_2023_2", "class_2024_2"}},
+		{[]string{"class_2024_*", "class_2024_1"}, []string{"class_2024_1", "class_2024_2"}},
+		{[]string{"exam_*"}, nil},
+	}
+
+	for _, tc := range tests {
+		expanded, _, err := ExpandTargetFolders(root, tc.folders)
+		if err != nil {
+			t.Fatalf("folders: %v, unexpected error: %v", tc.folders, err)
+		}
+
+		if strings.Join(expanded, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("folders: %v, expected: %v, got: %v", tc.folders, tc.expected, expanded)
+		}
+	}
+
+	if _, _, err := ExpandTargetFolders(root, []string{"re:("}); err == nil {
+		t.Error("expected error for invalid regex")
+	}
+}
@@ -29,7 +29,12 @@ func CopyRootDir(cfg *config.Config) error {
 	}
 
 	if cfg.SelectiveCopy {
-		for _, targetFolder := range cfg.TargetFolders {
+		targetFolders, _, err := ExpandTargetFolders(cfg.SourcePath, cfg.TargetFolders)
+		if err != nil {
+			return err
+		}
+
+		for _, targetFolder := range targetFolders {
 			sourcePath := filepath.Join(cfg.SourcePath, targetFolder)
 			targetPath := filepath.Join(cfg.WorkPath, targetFolder)
 
@@ -115,7 +120,12 @@ func VerifyWorkspace(cfg *config.Config) bool {
 	// sourcePath의 폴더/파일 개수 세기
 	if cfg.SelectiveCopy {
 		// selective_copy 모드: target_folders별로 검증
-		for _, targetFolder := range cfg.TargetFolders {
+		targetFolders, _, err := ExpandTargetFolders(cfg.SourcePath, cfg.TargetFolders)
+		if err != nil {
+			return false
+		}
+
+		for _, targetFolder := range targetFolders {
 			sourceFolderPath := filepath.Join(cfg.SourcePath, targetFolder)
 			workFolderPath := filepath.Join(cfg.WorkPath, targetFolder)
 